@@ -0,0 +1,155 @@
+// Package actions runs per-job shell command templates declared in a
+// tracker's frontmatter (e.g. "open portal", "submit via curl") without
+// ever going through a shell, so there is no command injection surface
+// from job data.
+package actions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Action is one named command template declared in a tracker's
+// "actions:" frontmatter block.
+type Action struct {
+	Name    string
+	Command string
+}
+
+// TemplateData is substituted into an Action's Command via
+// {{.Company}}, {{.Position}}, {{.ResumePath}} and {{.URL}}.
+type TemplateData struct {
+	Company    string
+	Position   string
+	ResumePath string
+	URL        string
+}
+
+// Result is the outcome of running an Action.
+type Result struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+}
+
+// Executor renders and runs Actions. Only executables named in Allowlist
+// may run; everything else is rejected before exec.CommandContext is
+// ever called.
+type Executor struct {
+	Allowlist map[string]struct{}
+	Timeout   time.Duration
+}
+
+func NewExecutor(allowlist []string, timeout time.Duration) *Executor {
+	set := make(map[string]struct{}, len(allowlist))
+	for _, exe := range allowlist {
+		set[exe] = struct{}{}
+	}
+	return &Executor{Allowlist: set, Timeout: timeout}
+}
+
+// Run renders action.Command against data and executes it via
+// exec.CommandContext (no shell interpolation: the rendered string is
+// split into argv ourselves).
+func (e *Executor) Run(ctx context.Context, action Action, data TemplateData) (Result, error) {
+	tmpl, err := template.New(action.Name).Parse(action.Command)
+	if err != nil {
+		return Result{}, fmt.Errorf("parse action template: %w", err)
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return Result{}, fmt.Errorf("render action template: %w", err)
+	}
+
+	argv, err := splitCommand(rendered.String())
+	if err != nil {
+		return Result{}, fmt.Errorf("split action command: %w", err)
+	}
+	if len(argv) == 0 {
+		return Result{}, fmt.Errorf("action %q rendered to an empty command", action.Name)
+	}
+	exe := argv[0]
+	if _, ok := e.Allowlist[exe]; !ok {
+		return Result{}, fmt.Errorf("executable %q is not in the actions allowlist", exe)
+	}
+
+	timeout := e.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(runCtx, exe, argv[1:]...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	runErr := cmd.Run()
+	exitCode := -1
+	if cmd.ProcessState != nil {
+		exitCode = cmd.ProcessState.ExitCode()
+	}
+	return Result{Stdout: stdout.String(), Stderr: stderr.String(), ExitCode: exitCode}, runErr
+}
+
+// splitCommand tokenizes a rendered action command into argv, honoring
+// single and double quotes (so a multi-word {{.Company}}/{{.Position}}
+// substitution like "Acme Corp" stays one argument) and backslash
+// escapes, the same way a shell would before exec — without ever
+// invoking a shell. An unterminated quote is an error rather than a
+// silently mis-tokenized command.
+func splitCommand(s string) ([]string, error) {
+	var argv []string
+	var cur strings.Builder
+	hasCur := false
+	var quote rune
+
+	flush := func() {
+		if hasCur {
+			argv = append(argv, cur.String())
+			cur.Reset()
+			hasCur = false
+		}
+	}
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+				continue
+			}
+			if quote == '"' && r == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+				i++
+				cur.WriteRune(runes[i])
+				continue
+			}
+			cur.WriteRune(r)
+		case r == '\'' || r == '"':
+			quote = r
+			hasCur = true
+		case r == '\\' && i+1 < len(runes):
+			i++
+			cur.WriteRune(runes[i])
+			hasCur = true
+		case r == ' ' || r == '\t' || r == '\n':
+			flush()
+		default:
+			cur.WriteRune(r)
+			hasCur = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %q quote", quote)
+	}
+	flush()
+	return argv, nil
+}
@@ -0,0 +1,59 @@
+package actions
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCommand(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "simple",
+			input: "open https://example.com",
+			want:  []string{"open", "https://example.com"},
+		},
+		{
+			name:  "double quoted multi-word value",
+			input: `curl -X POST https://example.com -d "company=Acme Corp"`,
+			want:  []string{"curl", "-X", "POST", "https://example.com", "-d", "company=Acme Corp"},
+		},
+		{
+			name:  "single quoted multi-word value",
+			input: `notify 'Senior Software Engineer'`,
+			want:  []string{"notify", "Senior Software Engineer"},
+		},
+		{
+			name:  "escaped quote inside double quotes",
+			input: `echo "say \"hi\""`,
+			want:  []string{"echo", `say "hi"`},
+		},
+		{
+			name:    "unterminated quote",
+			input:   `echo "unterminated`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := splitCommand(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("splitCommand(%q): expected error, got %v", tc.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("splitCommand(%q): unexpected error: %v", tc.input, err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("splitCommand(%q) = %#v, want %#v", tc.input, got, tc.want)
+			}
+		})
+	}
+}
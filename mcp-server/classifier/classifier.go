@@ -0,0 +1,25 @@
+// Package classifier decides whether a captured job posting should be
+// shortlisted, sent back for manual review, or rejected.
+package classifier
+
+const (
+	DecisionShortlist = "shortlist"
+	DecisionReviewed  = "reviewed"
+	DecisionReject    = "reject"
+)
+
+// Job is the subset of job posting fields a Classifier needs to reach a
+// decision. It mirrors the MCP server's JobRecord without importing it,
+// so this package stays free of the main package's persistence concerns.
+type Job struct {
+	Title       string
+	Company     string
+	Location    string
+	Description string
+}
+
+// Classifier assigns one of DecisionShortlist, DecisionReviewed or
+// DecisionReject to a job posting.
+type Classifier interface {
+	Classify(job Job) (string, error)
+}
@@ -0,0 +1,101 @@
+package classifier
+
+import (
+	"testing"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+)
+
+func TestPickLabelPicksClearWinner(t *testing.T) {
+	anchors := map[string][][]float32{
+		"shortlist": {{1, 0}},
+		"reject":    {{0, 1}},
+	}
+	vec := []float32{1, 0}
+
+	label, confident := pickLabel(anchors, vec, 1, 0.2)
+	if !confident {
+		t.Fatal("expected a confident pick, got fallback")
+	}
+	if label != "shortlist" {
+		t.Errorf("label = %q, want %q", label, "shortlist")
+	}
+}
+
+func TestPickLabelFallsBackBelowMargin(t *testing.T) {
+	anchors := map[string][][]float32{
+		"shortlist": {{1, 0}},
+		"reviewed":  {{0.99, 0.01}},
+	}
+	vec := []float32{1, 0}
+
+	// Both anchors score close to 1, so the winning margin is tiny -
+	// well under a 0.2 threshold - and the caller should fall back.
+	if _, confident := pickLabel(anchors, vec, 1, 0.2); confident {
+		t.Error("expected fallback when margin is too small, got a confident pick")
+	}
+}
+
+func TestPickLabelFallsBackWithNoAnchors(t *testing.T) {
+	if _, confident := pickLabel(map[string][][]float32{}, []float32{1, 0}, 3, 0.1); confident {
+		t.Error("expected fallback with no anchors, got a confident pick")
+	}
+}
+
+func aiConfig() config.ClassifierConfig {
+	return config.ClassifierConfig{
+		Positive: []config.TokenGroup{
+			{Name: "ai", Tokens: []string{"ml", "ai"}, Phrases: []string{"machine learning"}},
+		},
+		Required: []config.TokenGroup{
+			{Name: "production", Tokens: []string{"kubernetes", "docker"}},
+		},
+		Locations: config.LocationConfig{
+			Preferred: []string{"remote", "toronto"},
+		},
+	}
+}
+
+func TestRuleClassifierRejectsWithoutPositiveMatch(t *testing.T) {
+	c := &RuleClassifier{Config: aiConfig()}
+	decision, err := c.Classify(Job{Title: "Barista", Description: "make coffee"})
+	if err != nil {
+		t.Fatalf("Classify: %v", err)
+	}
+	if decision != DecisionReject {
+		t.Errorf("decision = %q, want %q", decision, DecisionReject)
+	}
+}
+
+func TestRuleClassifierReviewsWhenRequiredGroupMissing(t *testing.T) {
+	c := &RuleClassifier{Config: aiConfig(), RequireProduction: true}
+	decision, err := c.Classify(Job{Title: "ML Engineer", Description: "machine learning role, no deployment mentioned"})
+	if err != nil {
+		t.Fatalf("Classify: %v", err)
+	}
+	if decision != DecisionReviewed {
+		t.Errorf("decision = %q, want %q (missing required production group)", decision, DecisionReviewed)
+	}
+}
+
+func TestRuleClassifierReviewsWhenLocationNotPreferred(t *testing.T) {
+	c := &RuleClassifier{Config: aiConfig(), RequireLocation: true}
+	decision, err := c.Classify(Job{Title: "ML Engineer", Location: "Berlin", Description: "machine learning and docker"})
+	if err != nil {
+		t.Fatalf("Classify: %v", err)
+	}
+	if decision != DecisionReviewed {
+		t.Errorf("decision = %q, want %q (location not preferred)", decision, DecisionReviewed)
+	}
+}
+
+func TestRuleClassifierShortlistsWhenAllGatesPass(t *testing.T) {
+	c := &RuleClassifier{Config: aiConfig(), RequireLocation: true, RequireProduction: true}
+	decision, err := c.Classify(Job{Title: "ML Engineer", Location: "Remote", Description: "machine learning with docker and kubernetes"})
+	if err != nil {
+		t.Fatalf("Classify: %v", err)
+	}
+	if decision != DecisionShortlist {
+		t.Errorf("decision = %q, want %q", decision, DecisionShortlist)
+	}
+}
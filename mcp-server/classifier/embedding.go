@@ -0,0 +1,77 @@
+package classifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/vectorstore"
+)
+
+// EmbeddingClassifier compares a job's embedding against a set of labeled
+// anchor examples (shortlist/reviewed/reject) and picks the class with the
+// highest mean-of-top-k cosine similarity. Scores that tie or fall below
+// Margin fall back to Fallback, normally a RuleClassifier.
+type EmbeddingClassifier struct {
+	Store    *vectorstore.Store
+	Embedder vectorstore.Embedder
+	K        int
+	Margin   float64
+	Fallback Classifier
+}
+
+func (c *EmbeddingClassifier) Classify(job Job) (string, error) {
+	anchors, err := c.Store.AnchorsByLabel(c.Embedder.Model())
+	if err != nil {
+		return "", fmt.Errorf("load anchors: %w", err)
+	}
+	if len(anchors) == 0 {
+		return c.fallback(job)
+	}
+
+	text := job.Title + "\n" + job.Company + "\n" + job.Location + "\n" + job.Description
+	vec, err := c.Embedder.Embed(context.Background(), text)
+	if err != nil {
+		return "", fmt.Errorf("embed job: %w", err)
+	}
+
+	k := c.K
+	if k <= 0 {
+		k = 3
+	}
+
+	label, confident := pickLabel(anchors, vec, k, c.Margin)
+	if !confident {
+		return c.fallback(job)
+	}
+	return label, nil
+}
+
+func (c *EmbeddingClassifier) fallback(job Job) (string, error) {
+	if c.Fallback == nil {
+		return DecisionReviewed, nil
+	}
+	return c.Fallback.Classify(job)
+}
+
+// pickLabel picks the anchor label whose examples have the highest
+// mean-of-top-k cosine similarity to vec, reporting confident=false (so
+// the caller falls back) when no label scored above zero, the top two
+// labels tie, or the winner's margin over the runner-up is below margin.
+func pickLabel(anchors map[string][][]float32, vec []float32, k int, margin float64) (label string, confident bool) {
+	bestScore := 0.0
+	secondScore := 0.0
+	for l, examples := range anchors {
+		score := vectorstore.MeanTopK(vec, examples, k)
+		if score > bestScore {
+			secondScore = bestScore
+			bestScore = score
+			label = l
+		} else if score > secondScore {
+			secondScore = score
+		}
+	}
+	if label == "" || bestScore == secondScore || bestScore-secondScore < margin {
+		return "", false
+	}
+	return label, true
+}
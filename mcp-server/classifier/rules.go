@@ -0,0 +1,101 @@
+package classifier
+
+import (
+	"strings"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+)
+
+// RuleClassifier is the original keyword/phrase matcher, driven by a
+// config.ClassifierConfig instead of hardcoded keyword slices so the
+// taxonomy can change without a recompile.
+type RuleClassifier struct {
+	Config            config.ClassifierConfig
+	RequireLocation   bool
+	RequireProduction bool
+}
+
+func (c *RuleClassifier) Classify(job Job) (string, error) {
+	text := strings.ToLower(strings.TrimSpace(strings.Join([]string{
+		job.Title, job.Company, job.Location, job.Description,
+	}, " ")))
+	tokens := tokenize(text)
+
+	if !matchesAnyGroup(text, tokens, c.Config.Positive) {
+		return DecisionReject, nil
+	}
+
+	for _, group := range c.Config.Required {
+		if group.Name == "production" && !c.RequireProduction {
+			continue
+		}
+		if !matchesGroup(text, tokens, group) {
+			return DecisionReviewed, nil
+		}
+	}
+
+	locationOK := locationPreferred(job.Location, c.Config.Locations)
+	if c.RequireLocation && !locationOK {
+		return DecisionReviewed, nil
+	}
+
+	return DecisionShortlist, nil
+}
+
+func matchesGroup(text string, tokens map[string]struct{}, group config.TokenGroup) bool {
+	for _, t := range group.Tokens {
+		if _, ok := tokens[t]; ok {
+			return true
+		}
+	}
+	for _, p := range group.Phrases {
+		if strings.Contains(text, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAnyGroup(text string, tokens map[string]struct{}, groups []config.TokenGroup) bool {
+	for _, g := range groups {
+		if matchesGroup(text, tokens, g) {
+			return true
+		}
+	}
+	return false
+}
+
+func tokenize(text string) map[string]struct{} {
+	var b strings.Builder
+	for _, r := range text {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	words := strings.Fields(b.String())
+	out := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		out[w] = struct{}{}
+	}
+	return out
+}
+
+func locationPreferred(location string, loc config.LocationConfig) bool {
+	l := strings.ToLower(location)
+	if l == "" {
+		return false
+	}
+	for _, excluded := range loc.Excluded {
+		if strings.Contains(l, strings.ToLower(excluded)) {
+			return false
+		}
+	}
+	for _, preferred := range loc.Preferred {
+		if strings.Contains(l, strings.ToLower(preferred)) {
+			return true
+		}
+	}
+	return false
+}
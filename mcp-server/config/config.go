@@ -0,0 +1,155 @@
+// Package config loads the module's external, user-editable settings: the
+// classifier taxonomy, the capture DB path, the trackers directory and the
+// pdflatex binary path. Before this package existed, all of these were
+// either hardcoded in the classifier or resolved independently in each
+// tool handler.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TokenGroup is a named set of single-word tokens and multi-word phrases
+// that, together, represent one signal in the classifier (e.g. "ai" or
+// "production"). Weight is reserved for future scoring; today a group
+// matches if any of its tokens or phrases appears.
+type TokenGroup struct {
+	Name    string   `yaml:"name" json:"name"`
+	Tokens  []string `yaml:"tokens,omitempty" json:"tokens,omitempty"`
+	Phrases []string `yaml:"phrases,omitempty" json:"phrases,omitempty"`
+	Weight  float64  `yaml:"weight,omitempty" json:"weight,omitempty"`
+}
+
+// LocationConfig lists the location substrings that make a job's location
+// preferred or disqualifying.
+type LocationConfig struct {
+	Preferred []string `yaml:"preferred,omitempty" json:"preferred,omitempty"`
+	Excluded  []string `yaml:"excluded,omitempty" json:"excluded,omitempty"`
+}
+
+// ClassifierConfig is the taxonomy classifier.RuleClassifier matches
+// against. Positive groups are the signals a job must have at least one
+// of (e.g. AI/ML). Required groups must each have a match, unless the
+// group is gated off by a RuleClassifier flag (e.g. "production" is only
+// required when RequireProduction is set). Dealbreaker groups are kept
+// for visibility even though, today, missing a positive match already
+// rejects a job regardless of dealbreakers.
+type ClassifierConfig struct {
+	Positive     []TokenGroup   `yaml:"positive,omitempty" json:"positive,omitempty"`
+	Required     []TokenGroup   `yaml:"required,omitempty" json:"required,omitempty"`
+	Dealbreakers []TokenGroup   `yaml:"dealbreakers,omitempty" json:"dealbreakers,omitempty"`
+	Locations    LocationConfig `yaml:"locations,omitempty" json:"locations,omitempty"`
+}
+
+// ProgramConfig is the full set of settings loaded from config.yaml (or
+// config.json).
+type ProgramConfig struct {
+	Classifier   ClassifierConfig `yaml:"classifier,omitempty" json:"classifier,omitempty"`
+	DBPath       string           `yaml:"db_path,omitempty" json:"db_path,omitempty"`
+	TrackersDir  string           `yaml:"trackers_dir,omitempty" json:"trackers_dir,omitempty"`
+	PdflatexPath string           `yaml:"pdflatex_path,omitempty" json:"pdflatex_path,omitempty"`
+	Actions      ActionsConfig    `yaml:"actions,omitempty" json:"actions,omitempty"`
+}
+
+// ActionsConfig bounds what tracker-declared "actions:" commands
+// (run_job_action) are allowed to execute.
+type ActionsConfig struct {
+	Allowlist      []string `yaml:"allowlist,omitempty" json:"allowlist,omitempty"`
+	TimeoutSeconds int      `yaml:"timeout_seconds,omitempty" json:"timeout_seconds,omitempty"`
+}
+
+// Default returns the configuration that reproduces the classifier's
+// original hardcoded keyword sets, so a missing config file changes
+// nothing about existing behavior.
+func Default() ProgramConfig {
+	return ProgramConfig{
+		Classifier: ClassifierConfig{
+			Positive: []TokenGroup{
+				{
+					Name:    "ai",
+					Tokens:  []string{"ai", "ml", "llm", "nlp", "rag", "genai"},
+					Phrases: []string{"machine learning", "artificial intelligence", "deep learning", "computer vision"},
+					Weight:  1,
+				},
+			},
+			Required: []TokenGroup{
+				{
+					Name:   "production",
+					Tokens: []string{"production", "deploy", "deployment", "ci", "cd", "kubernetes", "docker", "pipeline", "scalable", "scalability", "cloud", "monitoring"},
+					Weight: 1,
+				},
+				{
+					Name:   "language",
+					Tokens: []string{"python", "pytorch", "tensorflow", "scikit", "sklearn", "keras"},
+					Weight: 1,
+				},
+			},
+			Dealbreakers: []TokenGroup{
+				{
+					Name:    "off_track_roles",
+					Phrases: []string{"front-end", "frontend", "react", "angular", "ios", "android", "qa", "tester", "sdet", "support", "help desk", "marketing", "sales", "recruiter", "wordpress", "designer"},
+				},
+				{
+					Name:    "sap_dotnet",
+					Tokens:  []string{"sap", "abap", "dotnet", "csharp", "c#", "vb"},
+					Phrases: []string{".net"},
+				},
+			},
+			Locations: LocationConfig{
+				Preferred: []string{"ontario", "toronto", "ottawa", "waterloo", "gta", "canada", "remote"},
+			},
+		},
+		Actions: ActionsConfig{
+			Allowlist:      []string{"curl", "open", "xdg-open"},
+			TimeoutSeconds: 30,
+		},
+	}
+}
+
+// Load reads and merges the config file over Default(). root is the
+// module's base directory (used to resolve the default config.yaml
+// path); override, when non-empty, takes precedence over both root and
+// JOBWORKFLOW_CONFIG. A missing file is not an error: Default() is
+// returned unchanged.
+func Load(root, override string) (ProgramConfig, error) {
+	path := override
+	if path == "" {
+		path = os.Getenv("JOBWORKFLOW_CONFIG")
+	}
+	if path == "" {
+		path = filepath.Join(root, "config.yaml")
+	}
+
+	cfg := Default()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return ProgramConfig{}, fmt.Errorf("read config %s: %w", path, err)
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&cfg); err != nil {
+			return ProgramConfig{}, fmt.Errorf("parse config %s: %w", path, err)
+		}
+		return cfg, nil
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil {
+		return ProgramConfig{}, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return cfg, nil
+}
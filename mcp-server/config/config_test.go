@@ -0,0 +1,92 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadMissingFileReturnsDefault(t *testing.T) {
+	root := t.TempDir()
+	cfg, err := Load(root, "")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !reflect.DeepEqual(cfg, Default()) {
+		t.Errorf("Load with no config file = %+v, want Default()", cfg)
+	}
+}
+
+func TestLoadRejectsUnknownYAMLKey(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "config.yaml")
+	if err := os.WriteFile(path, []byte("db_path: jobs.db\nnot_a_real_field: true\n"), 0644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if _, err := Load(root, ""); err == nil {
+		t.Error("expected error for unknown YAML key, got nil")
+	}
+}
+
+func TestLoadRejectsUnknownJSONKey(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "config.json")
+	if err := os.WriteFile(path, []byte(`{"db_path": "jobs.db", "not_a_real_field": true}`), 0644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if _, err := Load(root, path); err == nil {
+		t.Error("expected error for unknown JSON key, got nil")
+	}
+}
+
+func TestLoadRejectsMalformedYAML(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "config.yaml")
+	if err := os.WriteFile(path, []byte("db_path: [unterminated\n"), 0644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if _, err := Load(root, ""); err == nil {
+		t.Error("expected error for malformed YAML, got nil")
+	}
+}
+
+func TestLoadOverridePathTakesPrecedenceOverEnv(t *testing.T) {
+	root := t.TempDir()
+
+	envPath := filepath.Join(root, "env-config.yaml")
+	if err := os.WriteFile(envPath, []byte("db_path: from-env.db\n"), 0644); err != nil {
+		t.Fatalf("write env config: %v", err)
+	}
+	overridePath := filepath.Join(root, "override-config.yaml")
+	if err := os.WriteFile(overridePath, []byte("db_path: from-override.db\n"), 0644); err != nil {
+		t.Fatalf("write override config: %v", err)
+	}
+
+	t.Setenv("JOBWORKFLOW_CONFIG", envPath)
+
+	cfg, err := Load(root, overridePath)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.DBPath != "from-override.db" {
+		t.Errorf("DBPath = %q, want %q (override should win over JOBWORKFLOW_CONFIG)", cfg.DBPath, "from-override.db")
+	}
+}
+
+func TestLoadFallsBackToEnvWhenNoOverride(t *testing.T) {
+	root := t.TempDir()
+	envPath := filepath.Join(root, "env-config.yaml")
+	if err := os.WriteFile(envPath, []byte("db_path: from-env.db\n"), 0644); err != nil {
+		t.Fatalf("write env config: %v", err)
+	}
+	t.Setenv("JOBWORKFLOW_CONFIG", envPath)
+
+	cfg, err := Load(root, "")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.DBPath != "from-env.db" {
+		t.Errorf("DBPath = %q, want %q (JOBWORKFLOW_CONFIG should be used)", cfg.DBPath, "from-env.db")
+	}
+}
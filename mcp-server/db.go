@@ -2,13 +2,19 @@ package main
 
 import (
 	"database/sql"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	_ "modernc.org/sqlite"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/events"
 )
 
 func openCaptureDB(dbPath string) (*sql.DB, error) {
+	events.Init()
+
 	if dbPath == "" {
 		if env := os.Getenv("JOBWORKFLOW_DB"); env != "" {
 			dbPath = env
@@ -20,7 +26,162 @@ func openCaptureDB(dbPath string) (*sql.DB, error) {
 	if err := os.MkdirAll(filepath.Dir(dbPath), 0755); err != nil {
 		return nil, err
 	}
-	return sql.Open("sqlite", dbPath)
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureSearchSchema(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// ftsEnabled records whether the opened SQLite build supports FTS5. When it
+// doesn't, searchJobs falls back to a plain LIKE scan.
+var ftsEnabled bool
+
+// ensureSearchSchema creates the jobs_fts external-content index and the
+// triggers that keep it in sync with the jobs table. SQLite builds without
+// FTS5 compiled in are left with ftsEnabled = false and searchJobs falls
+// back to searchJobsLike instead.
+func ensureSearchSchema(db *sql.DB) error {
+	if _, err := db.Exec(`
+		CREATE VIRTUAL TABLE IF NOT EXISTS jobs_fts USING fts5(
+			title, description, company, location,
+			content='jobs', content_rowid='rowid'
+		)
+	`); err != nil {
+		ftsEnabled = false
+		return nil
+	}
+	ftsEnabled = true
+
+	triggers := []string{
+		`CREATE TRIGGER IF NOT EXISTS jobs_fts_insert AFTER INSERT ON jobs BEGIN
+			INSERT INTO jobs_fts(rowid, title, description, company, location)
+			VALUES (new.rowid, new.title, new.description, new.company, new.location);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS jobs_fts_update AFTER UPDATE ON jobs BEGIN
+			INSERT INTO jobs_fts(jobs_fts, rowid, title, description, company, location)
+			VALUES ('delete', old.rowid, old.title, old.description, old.company, old.location);
+			INSERT INTO jobs_fts(rowid, title, description, company, location)
+			VALUES (new.rowid, new.title, new.description, new.company, new.location);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS jobs_fts_delete AFTER DELETE ON jobs BEGIN
+			INSERT INTO jobs_fts(jobs_fts, rowid, title, description, company, location)
+			VALUES ('delete', old.rowid, old.title, old.description, old.company, old.location);
+		END`,
+	}
+	for _, stmt := range triggers {
+		if _, err := db.Exec(stmt); err != nil {
+			return err
+		}
+	}
+
+	_, err := db.Exec(`
+		INSERT INTO jobs_fts(rowid, title, description, company, location)
+		SELECT rowid, title, description, company, location FROM jobs
+		WHERE rowid NOT IN (SELECT rowid FROM jobs_fts)
+	`)
+	return err
+}
+
+// searchJobs runs a full-text search over captured jobs, using FTS5/BM25
+// ranking when available and falling back to a LIKE scan otherwise.
+// statusFilter is optional; an empty string matches any status.
+func searchJobs(db *sql.DB, query, statusFilter string, limit int, highlight bool) ([]JobSearchResult, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if ftsEnabled {
+		return searchJobsFTS(db, query, statusFilter, limit, highlight)
+	}
+	return searchJobsLike(db, query, statusFilter, limit)
+}
+
+func searchJobsFTS(db *sql.DB, query, statusFilter string, limit int, highlight bool) ([]JobSearchResult, error) {
+	titleMark, titleMarkEnd := "", ""
+	descMark, descMarkEnd := "", ""
+	if highlight {
+		titleMark, titleMarkEnd = "[", "]"
+		descMark, descMarkEnd = "[", "]"
+	}
+
+	sqlQuery := fmt.Sprintf(`
+		SELECT j.url, j.title, j.description, j.company, j.job_id, j.location, j.status,
+			snippet(jobs_fts, 0, '%s', '%s', '...', 8) AS title_snippet,
+			snippet(jobs_fts, 1, '%s', '%s', '...', 12) AS description_snippet,
+			bm25(jobs_fts) AS rank
+		FROM jobs_fts
+		JOIN jobs j ON j.rowid = jobs_fts.rowid
+		WHERE jobs_fts MATCH ?
+		%s
+		ORDER BY rank
+		LIMIT ?
+	`, titleMark, titleMarkEnd, descMark, descMarkEnd, statusClause(statusFilter))
+
+	args := []interface{}{query}
+	if statusFilter != "" {
+		args = append(args, statusFilter)
+	}
+	args = append(args, limit)
+
+	rows, err := db.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanSearchResults(rows)
+}
+
+func searchJobsLike(db *sql.DB, query, statusFilter string, limit int) ([]JobSearchResult, error) {
+	like := "%" + query + "%"
+	sqlQuery := fmt.Sprintf(`
+		SELECT url, title, description, company, job_id, location, status,
+			title, description, 0.0 AS rank
+		FROM jobs
+		WHERE (title LIKE ? OR description LIKE ? OR company LIKE ? OR location LIKE ?)
+		%s
+		ORDER BY created_at DESC
+		LIMIT ?
+	`, statusClause(statusFilter))
+
+	args := []interface{}{like, like, like, like}
+	if statusFilter != "" {
+		args = append(args, statusFilter)
+	}
+	args = append(args, limit)
+
+	rows, err := db.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanSearchResults(rows)
+}
+
+// statusClause returns a SQL "AND status = ?" fragment when statusFilter is
+// set, or an empty string otherwise. The caller is responsible for
+// appending statusFilter to its argument list in the same order.
+func statusClause(statusFilter string) string {
+	if statusFilter == "" {
+		return ""
+	}
+	return "AND status = ?"
+}
+
+func scanSearchResults(rows *sql.Rows) ([]JobSearchResult, error) {
+	var results []JobSearchResult
+	for rows.Next() {
+		var r JobSearchResult
+		if err := rows.Scan(&r.URL, &r.Title, &r.Description, &r.Company, &r.JobID, &r.Location,
+			&r.Status, &r.TitleSnippet, &r.DescriptionSnippet, &r.Rank); err != nil {
+			continue
+		}
+		results = append(results, r)
+	}
+	return results, nil
 }
 
 func fetchJobsByStatus(db *sql.DB, status string, limit int) ([]JobRecord, error) {
@@ -48,8 +209,14 @@ func fetchJobsByStatus(db *sql.DB, status string, limit int) ([]JobRecord, error
 }
 
 func updateJobStatus(db *sql.DB, url, status string) error {
-	_, err := db.Exec(`UPDATE jobs SET status = ? WHERE url = ?`, status, url)
-	return err
+	var fromStatus string
+	_ = db.QueryRow(`SELECT status FROM jobs WHERE url = ?`, url).Scan(&fromStatus)
+
+	if _, err := db.Exec(`UPDATE jobs SET status = ? WHERE url = ?`, status, url); err != nil {
+		return err
+	}
+	events.PublishJobStatusChanged(url, fromStatus, status)
+	return nil
 }
 
 func updateJobsStatus(db *sql.DB, fromStatus, toStatus string, limit int, dryRun bool) (int64, error) {
@@ -64,16 +231,28 @@ func updateJobsStatus(db *sql.DB, fromStatus, toStatus string, limit int, dryRun
 			}
 			return count, nil
 		}
+
+		urls, err := fetchURLsByStatus(db, fromStatus, limit)
+		if err != nil {
+			return 0, err
+		}
+		if len(urls) == 0 {
+			return 0, nil
+		}
 		result, err := db.Exec(`
 			UPDATE jobs SET status = ?
-			WHERE url IN (
-				SELECT url FROM jobs WHERE status = ? ORDER BY created_at DESC LIMIT ?
-			)
-		`, toStatus, fromStatus, limit)
+			WHERE url IN (`+placeholders(len(urls))+`)
+		`, append([]interface{}{toStatus}, urlsToArgs(urls)...)...)
 		if err != nil {
 			return 0, err
 		}
-		return result.RowsAffected()
+		affected, err := result.RowsAffected()
+		if err == nil {
+			for _, url := range urls {
+				events.PublishJobStatusChanged(url, fromStatus, toStatus)
+			}
+		}
+		return affected, err
 	}
 
 	if dryRun {
@@ -84,9 +263,62 @@ func updateJobsStatus(db *sql.DB, fromStatus, toStatus string, limit int, dryRun
 		}
 		return count, nil
 	}
+
+	urls, err := fetchURLsByStatus(db, fromStatus, 0)
+	if err != nil {
+		return 0, err
+	}
 	result, err := db.Exec(`UPDATE jobs SET status = ? WHERE status = ?`, toStatus, fromStatus)
 	if err != nil {
 		return 0, err
 	}
-	return result.RowsAffected()
+	affected, err := result.RowsAffected()
+	if err == nil {
+		for _, url := range urls {
+			events.PublishJobStatusChanged(url, fromStatus, toStatus)
+		}
+	}
+	return affected, err
+}
+
+// fetchURLsByStatus returns the URLs of jobs with the given status, in the
+// same order updateJobsStatus's bulk UPDATE selects them in, so the
+// per-job status_changed events it publishes name the jobs that actually
+// changed. limit <= 0 means no limit.
+func fetchURLsByStatus(db *sql.DB, status string, limit int) ([]string, error) {
+	query := `SELECT url FROM jobs WHERE status = ? ORDER BY created_at DESC`
+	args := []interface{}{status}
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var urls []string
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			continue
+		}
+		urls = append(urls, url)
+	}
+	return urls, nil
+}
+
+// placeholders returns "?, ?, ..." with n placeholders, for building an IN
+// clause sized to a dynamic URL list.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
+
+func urlsToArgs(urls []string) []interface{} {
+	args := make([]interface{}, len(urls))
+	for i, url := range urls {
+		args[i] = url
+	}
+	return args
 }
@@ -0,0 +1,202 @@
+// Package events publishes structured JSON notifications over MQTT
+// whenever a tracker or job changes state, so a dashboard, a
+// home-automation setup, or a second MCP client can subscribe instead of
+// polling the SQLite capture DB. It is configured entirely from
+// environment variables and is a no-op when JOBWORKFLOW_MQTT_BROKER is
+// unset, so the CLI keeps working offline.
+package events
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// Publisher emits retained, hierarchical-topic JSON events over MQTT.
+type Publisher struct {
+	client mqtt.Client
+	prefix string
+}
+
+var (
+	once      sync.Once
+	singleton *Publisher
+)
+
+// Init connects the package-level singleton publisher from environment
+// variables, if configured. It is safe to call more than once; only the
+// first call takes effect. Call it from openCaptureDB's startup path so
+// every process that opens the capture DB also has a publisher ready.
+func Init() {
+	once.Do(func() {
+		broker := strings.TrimSpace(os.Getenv("JOBWORKFLOW_MQTT_BROKER"))
+		if broker == "" {
+			singleton = &Publisher{}
+			return
+		}
+
+		prefix := strings.TrimSpace(os.Getenv("JOBWORKFLOW_MQTT_TOPIC_PREFIX"))
+		if prefix == "" {
+			prefix = "jobworkflow"
+		}
+
+		opts := mqtt.NewClientOptions().
+			AddBroker(broker).
+			SetClientID(clientIDOrDefault()).
+			SetAutoReconnect(true)
+
+		if user := os.Getenv("JOBWORKFLOW_MQTT_USERNAME"); user != "" {
+			opts.SetUsername(user)
+			opts.SetPassword(os.Getenv("JOBWORKFLOW_MQTT_PASSWORD"))
+		}
+		if tlsConfig, err := buildTLSConfig(); err == nil && tlsConfig != nil {
+			opts.SetTLSConfig(tlsConfig)
+		}
+
+		client := mqtt.NewClient(opts)
+		token := client.Connect()
+		token.Wait()
+		if err := token.Error(); err != nil {
+			fmt.Fprintf(os.Stderr, "events: failed to connect to MQTT broker %s: %v\n", broker, err)
+			singleton = &Publisher{}
+			return
+		}
+
+		singleton = &Publisher{client: client, prefix: prefix}
+	})
+}
+
+func clientIDOrDefault() string {
+	if id := strings.TrimSpace(os.Getenv("JOBWORKFLOW_MQTT_CLIENT_ID")); id != "" {
+		return id
+	}
+	return fmt.Sprintf("jobworkflow-%d", time.Now().UnixNano())
+}
+
+func buildTLSConfig() (*tls.Config, error) {
+	caFile := os.Getenv("JOBWORKFLOW_MQTT_CA_FILE")
+	certFile := os.Getenv("JOBWORKFLOW_MQTT_CERT_FILE")
+	keyFile := os.Getenv("JOBWORKFLOW_MQTT_KEY_FILE")
+	if caFile == "" && certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		InsecureSkipVerify: strings.EqualFold(os.Getenv("JOBWORKFLOW_MQTT_INSECURE_SKIP_VERIFY"), "true"),
+	}
+
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates parsed from %s", caFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if certFile != "" && keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// publish marshals payload as JSON and publishes it, retained, on
+// "<prefix>/<topic>". It is a no-op if Init has not connected a broker.
+func publish(topic string, payload interface{}) {
+	if singleton == nil || singleton.client == nil {
+		return
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	singleton.client.Publish(singleton.prefix+"/"+topic, 0, true, body)
+}
+
+// TrackerCreated is published to "tracker/created/<slug>" when a new
+// tracker file is written.
+type TrackerCreated struct {
+	Slug        string    `json:"slug"`
+	Company     string    `json:"company"`
+	Position    string    `json:"position"`
+	TrackerPath string    `json:"tracker_path"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// PublishTrackerCreated announces a new tracker file.
+func PublishTrackerCreated(slug, company, position, trackerPath string) {
+	publish("tracker/created/"+slug, TrackerCreated{
+		Slug:        slug,
+		Company:     company,
+		Position:    position,
+		TrackerPath: trackerPath,
+		CreatedAt:   time.Now().UTC(),
+	})
+}
+
+// TrackerStatusChanged is published to "tracker/status_changed/<slug>"
+// when updateTrackerStatus mutates a tracker's frontmatter.
+type TrackerStatusChanged struct {
+	Slug        string    `json:"slug"`
+	TrackerPath string    `json:"tracker_path"`
+	FromStatus  string    `json:"from_status"`
+	ToStatus    string    `json:"to_status"`
+	ChangedAt   time.Time `json:"changed_at"`
+}
+
+// PublishTrackerStatusChanged announces a tracker status transition.
+func PublishTrackerStatusChanged(slug, trackerPath, fromStatus, toStatus string) {
+	publish("tracker/status_changed/"+slug, TrackerStatusChanged{
+		Slug:        slug,
+		TrackerPath: trackerPath,
+		FromStatus:  fromStatus,
+		ToStatus:    toStatus,
+		ChangedAt:   time.Now().UTC(),
+	})
+}
+
+// JobStatusChanged is published to "job/status_changed/<from>/<to>" when
+// a captured job's status changes.
+type JobStatusChanged struct {
+	URL        string    `json:"url"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
+
+// PublishJobStatusChanged announces a captured job's status transition.
+func PublishJobStatusChanged(url, fromStatus, toStatus string) {
+	topic := fmt.Sprintf("job/status_changed/%s/%s", topicSegment(fromStatus), topicSegment(toStatus))
+	publish(topic, JobStatusChanged{
+		URL:        url,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		ChangedAt:  time.Now().UTC(),
+	})
+}
+
+// topicSegment sanitizes a status value for use as an MQTT topic level,
+// since "+" and "#" are wildcards and "/" would add an extra level.
+func topicSegment(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "unknown"
+	}
+	replacer := strings.NewReplacer("/", "_", "+", "_", "#", "_", " ", "_")
+	return replacer.Replace(s)
+}
@@ -0,0 +1,121 @@
+// Package followups persists one-off "do this later" jobs — e.g. "ping me
+// about this tracker in 7 days" — separately from the scheduler package's
+// recurring named tasks. Claiming uses an UPDATE ... RETURNING so multiple
+// processes polling the same DB never apply the same follow-up twice.
+package followups
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Followup is a single persisted job_followups row.
+type Followup struct {
+	ID          int64
+	Kind        string
+	NextRunAt   time.Time
+	PayloadJSON string
+	Status      string
+	LastError   string
+}
+
+// Store wraps the capture DB with the job_followups table operations.
+type Store struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *Store {
+	return &Store{db: db}
+}
+
+// EnsureSchema creates the job_followups table if it does not already
+// exist. Safe to call on every startup.
+func (s *Store) EnsureSchema() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS job_followups (
+			id           INTEGER PRIMARY KEY AUTOINCREMENT,
+			kind         TEXT NOT NULL,
+			next_run_at  TIMESTAMP NOT NULL,
+			payload_json TEXT NOT NULL DEFAULT '{}',
+			status       TEXT NOT NULL DEFAULT 'pending',
+			last_error   TEXT NOT NULL DEFAULT ''
+		)
+	`)
+	return err
+}
+
+// Schedule inserts a new pending follow-up and returns its id.
+func (s *Store) Schedule(kind string, runAt time.Time, payloadJSON string) (int64, error) {
+	result, err := s.db.Exec(`
+		INSERT INTO job_followups (kind, next_run_at, payload_json, status)
+		VALUES (?, ?, ?, 'pending')
+	`, kind, runAt.UTC(), payloadJSON)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+// ClaimDue atomically claims up to limit pending follow-ups whose
+// next_run_at has passed, marking them "running" in the same statement so
+// a second process (or the next tick) cannot claim them again.
+func (s *Store) ClaimDue(now time.Time, limit int) ([]Followup, error) {
+	rows, err := s.db.Query(`
+		UPDATE job_followups
+		SET status = 'running'
+		WHERE id IN (
+			SELECT id FROM job_followups
+			WHERE status = 'pending' AND next_run_at <= ?
+			ORDER BY next_run_at
+			LIMIT ?
+		)
+		RETURNING id, kind, next_run_at, payload_json, status, last_error
+	`, now.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Followup
+	for rows.Next() {
+		var f Followup
+		if err := rows.Scan(&f.ID, &f.Kind, &f.NextRunAt, &f.PayloadJSON, &f.Status, &f.LastError); err != nil {
+			continue
+		}
+		out = append(out, f)
+	}
+	return out, rows.Err()
+}
+
+// Complete marks a claimed follow-up as done, or failed with errText when
+// errText is non-empty.
+func (s *Store) Complete(id int64, errText string) error {
+	status := "done"
+	if errText != "" {
+		status = "failed"
+	}
+	_, err := s.db.Exec(`UPDATE job_followups SET status = ?, last_error = ? WHERE id = ?`, status, errText, id)
+	return err
+}
+
+// List returns the most recently scheduled follow-ups, newest first.
+func (s *Store) List(limit int) ([]Followup, error) {
+	rows, err := s.db.Query(`
+		SELECT id, kind, next_run_at, payload_json, status, last_error
+		FROM job_followups ORDER BY id DESC LIMIT ?
+	`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Followup
+	for rows.Next() {
+		var f Followup
+		if err := rows.Scan(&f.ID, &f.Kind, &f.NextRunAt, &f.PayloadJSON, &f.Status, &f.LastError); err != nil {
+			continue
+		}
+		out = append(out, f)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/httpapi"
+)
+
+// startHTTPServer exposes a read/write REST façade over the capture DB and
+// trackers, so the module is usable from a browser or curl without an MCP
+// client. It reuses the same backend functions the MCP tools call, so the
+// two surfaces stay behavior-equivalent.
+func startHTTPServer(addr string, cfg config.ProgramConfig) error {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /jobs", func(w http.ResponseWriter, r *http.Request) {
+		db, err := openCaptureDB(cfg.DBPath)
+		if err != nil {
+			httpError(w, http.StatusInternalServerError, err)
+			return
+		}
+		defer db.Close()
+
+		filter := httpapi.BuildFilterPresets(r.URL.Query())
+		jobs, err := filter.Apply(db)
+		if err != nil {
+			httpError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, jobs)
+	})
+
+	mux.HandleFunc("PATCH /jobs/{url}/status", func(w http.ResponseWriter, r *http.Request) {
+		jobURL, err := url.QueryUnescape(r.PathValue("url"))
+		if err != nil {
+			httpError(w, http.StatusBadRequest, err)
+			return
+		}
+		var body struct {
+			Status string `json:"status"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Status == "" {
+			httpError(w, http.StatusBadRequest, fmt.Errorf("body must be {\"status\": \"...\"}"))
+			return
+		}
+
+		db, err := openCaptureDB(cfg.DBPath)
+		if err != nil {
+			httpError(w, http.StatusInternalServerError, err)
+			return
+		}
+		defer db.Close()
+
+		if err := updateJobStatus(db, jobURL, body.Status); err != nil {
+			httpError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"url": jobURL, "status": body.Status})
+	})
+
+	mux.HandleFunc("POST /jobs/{url}/tailor", func(w http.ResponseWriter, r *http.Request) {
+		jobURL, err := url.QueryUnescape(r.PathValue("url"))
+		if err != nil {
+			httpError(w, http.StatusBadRequest, err)
+			return
+		}
+		var body struct {
+			TrackerPath string `json:"tracker_path"`
+			Compile     bool   `json:"compile"`
+			Force       bool   `json:"force"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.TrackerPath == "" {
+			httpError(w, http.StatusBadRequest, fmt.Errorf("body must include \"tracker_path\""))
+			return
+		}
+
+		if err := requireUnderTrackersDir(cfg, body.TrackerPath); err != nil {
+			httpError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		pdflatex := cfg.PdflatexPath
+		result, err := runCareerTailor(body.TrackerPath, body.Compile, body.Force, pdflatex)
+		if err != nil {
+			httpError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"url": jobURL, "result": result})
+	})
+
+	mux.HandleFunc("GET /trackers/{file}", func(w http.ResponseWriter, r *http.Request) {
+		trackersDir, err := absTrackersDir(cfg)
+		if err != nil {
+			httpError(w, http.StatusInternalServerError, err)
+			return
+		}
+		path := filepath.Join(trackersDir, r.PathValue("file"))
+		if !pathUnder(path, trackersDir) {
+			httpError(w, http.StatusBadRequest, fmt.Errorf("invalid tracker file"))
+			return
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			httpError(w, http.StatusNotFound, err)
+			return
+		}
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		w.Write(content)
+	})
+
+	return http.ListenAndServe(addr, mux)
+}
+
+// absTrackersDir resolves cfg's trackers directory to an absolute path, the
+// same way GET /trackers/{file} and requireUnderTrackersDir contain paths
+// against it.
+func absTrackersDir(cfg config.ProgramConfig) (string, error) {
+	trackersDir := cfg.TrackersDir
+	if trackersDir == "" {
+		trackersDir = filepath.Join(resolveBaseDir(), "trackers")
+	}
+	return filepath.Abs(trackersDir)
+}
+
+// pathUnder reports whether path is dir itself or a descendant of it.
+func pathUnder(path, dir string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(filepath.Separator))
+}
+
+// requireUnderTrackersDir rejects a user-supplied tracker_path that would
+// resolve (via the same expandHome/baseDir-relative logic runCareerTailor
+// uses) to a file outside cfg's trackers directory. POST /jobs/{url}/tailor
+// is network-exposed, unlike the trusted local MCP stdio path, so an
+// absolute or ../-escaping tracker_path must be rejected before it reaches
+// runCareerTailor.
+func requireUnderTrackersDir(cfg config.ProgramConfig, trackerPath string) error {
+	trackersDir, err := absTrackersDir(cfg)
+	if err != nil {
+		return err
+	}
+
+	resolved := expandHome(trackerPath)
+	if !filepath.IsAbs(resolved) {
+		resolved = filepath.Join(resolveBaseDir(), resolved)
+	}
+	resolved, err = filepath.Abs(resolved)
+	if err != nil {
+		return err
+	}
+	if !pathUnder(resolved, trackersDir) {
+		return fmt.Errorf("tracker_path must be under the trackers directory")
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func httpError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
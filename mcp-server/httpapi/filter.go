@@ -0,0 +1,102 @@
+// Package httpapi builds parameterized SQL queries from URL query-string
+// filter presets, the way cluster-job dashboards let you narrow a list
+// with "?status=shortlist&company=acme" instead of hand-writing SQL.
+package httpapi
+
+import (
+	"database/sql"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Job is a row from the jobs table, including its status (unlike the MCP
+// server's JobRecord, which always queries a single known status).
+type Job struct {
+	URL         string
+	Title       string
+	Description string
+	Company     string
+	JobID       string
+	Location    string
+	Status      string
+}
+
+// JobFilter is a parsed set of query-string presets.
+type JobFilter struct {
+	Status    string
+	Company   string
+	Location  string
+	HasPython *bool
+	Limit     int
+}
+
+// BuildFilterPresets parses recognized query parameters
+// (status, company, location, has_python, limit) into a JobFilter.
+// Unrecognized parameters are ignored.
+func BuildFilterPresets(values url.Values) JobFilter {
+	f := JobFilter{Limit: 50}
+	f.Status = strings.TrimSpace(values.Get("status"))
+	f.Company = strings.TrimSpace(values.Get("company"))
+	f.Location = strings.TrimSpace(values.Get("location"))
+	if v := values.Get("has_python"); v != "" {
+		b := strings.EqualFold(v, "true")
+		f.HasPython = &b
+	}
+	if v := values.Get("limit"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			f.Limit = n
+		}
+	}
+	return f
+}
+
+// Apply runs the filter as a parameterized SQL query against db.
+func (f JobFilter) Apply(db *sql.DB) ([]Job, error) {
+	var clauses []string
+	var args []interface{}
+
+	if f.Status != "" {
+		clauses = append(clauses, "status = ?")
+		args = append(args, f.Status)
+	}
+	if f.Company != "" {
+		clauses = append(clauses, "company LIKE ?")
+		args = append(args, "%"+f.Company+"%")
+	}
+	if f.Location != "" {
+		clauses = append(clauses, "location LIKE ?")
+		args = append(args, "%"+f.Location+"%")
+	}
+	if f.HasPython != nil {
+		pythonClause := "(lower(title) LIKE '%python%' OR lower(description) LIKE '%python%')"
+		if *f.HasPython {
+			clauses = append(clauses, pythonClause)
+		} else {
+			clauses = append(clauses, "NOT "+pythonClause)
+		}
+	}
+
+	query := "SELECT url, title, description, company, job_id, location, status FROM jobs"
+	if len(clauses) > 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+	query += " ORDER BY created_at DESC LIMIT ?"
+	args = append(args, f.Limit)
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []Job
+	for rows.Next() {
+		var j Job
+		if err := rows.Scan(&j.URL, &j.Title, &j.Description, &j.Company, &j.JobID, &j.Location, &j.Status); err != nil {
+			continue
+		}
+		jobs = append(jobs, j)
+	}
+	return jobs, rows.Err()
+}
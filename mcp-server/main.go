@@ -2,17 +2,56 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/metrics"
 )
 
 func main() {
+	jobserver := flag.Bool("jobserver", false, "run as a background scheduler worker instead of serving MCP stdio")
+	configPath := flag.String("config", "", "path to config.yaml/config.json (default: JOBWORKFLOW_CONFIG or <root>/config.yaml)")
+	metricsAddr := flag.String("metrics-addr", "", "if set, serve Prometheus metrics at this address (e.g. :9090) alongside MCP stdio")
+	httpAddr := flag.String("http-addr", "", "if set, serve the REST API at this address (e.g. :8080) alongside MCP stdio")
+	flag.Parse()
+
+	cfg, err := config.Load(resolveBaseDir(), *configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *metricsAddr != "" {
+		go func() {
+			if err := metrics.Serve(*metricsAddr); err != nil {
+				fmt.Fprintf(os.Stderr, "metrics server error: %v\n", err)
+			}
+		}()
+	}
+
+	if *httpAddr != "" {
+		go func() {
+			if err := startHTTPServer(*httpAddr, cfg); err != nil {
+				fmt.Fprintf(os.Stderr, "http server error: %v\n", err)
+			}
+		}()
+	}
+
+	if *jobserver {
+		runJobserver(cfg)
+		return
+	}
+
 	s := server.NewMCPServer("kaka-job-scout", "1.0.0")
 
 	tool := mcp.NewTool("initialize_job",
@@ -54,12 +93,76 @@ func main() {
 		return mcp.NewToolResultText(fmt.Sprintf("Successfully initialized job application for %s as %s", company, position)), nil
 	})
 
+	registerAutoFilterJobs(s, cfg)
+	registerInitializeJobsBatch(s, cfg)
+	registerUpdateJobsStatus(s, cfg)
+	registerUpdateTrackerStatus(s, cfg)
+	registerPatchTrackerFields(s, cfg)
+	registerCareerTailor(s, cfg)
+	registerTrainAnchors(s, cfg)
+	registerDumpConfig(s, cfg)
+	registerJobActions(s, cfg)
+	registerSearchJobs(s, cfg)
+
+	db, err := openCaptureDB(cfg.DBPath)
+	if err != nil {
+		metrics.RecordDBOpenError()
+		fmt.Fprintf(os.Stderr, "Failed to open capture DB: %v\n", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+	if *metricsAddr != "" {
+		metrics.StartJobsByStatusRefresher(context.Background(), db, 30*time.Second)
+	}
+	followupStore, err := buildFollowupStore(db)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to build followup store: %v\n", err)
+		os.Exit(1)
+	}
+	registerFollowupTools(s, followupStore)
+
+	sch, err := buildScheduler(db, cfg, followupStore)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to build scheduler: %v\n", err)
+		os.Exit(1)
+	}
+	registerSchedulerTools(s, sch, db, cfg, followupStore)
+
 	if err := server.ServeStdio(s); err != nil {
 		fmt.Fprintf(os.Stderr, "Server error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
+// runJobserver runs the background scheduler until the process receives
+// an interrupt, serving no MCP traffic. It is the --jobserver mode.
+func runJobserver(cfg config.ProgramConfig) {
+	db, err := openCaptureDB(cfg.DBPath)
+	if err != nil {
+		metrics.RecordDBOpenError()
+		fmt.Fprintf(os.Stderr, "Failed to open capture DB: %v\n", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	followupStore, err := buildFollowupStore(db)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to build followup store: %v\n", err)
+		os.Exit(1)
+	}
+	sch, err := buildScheduler(db, cfg, followupStore)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to build scheduler: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintln(os.Stderr, "jobserver: running scheduled tasks in the background")
+	sch.Start(ctx, 30*time.Second)
+}
+
 func runInitialization(company, position, url, jd string) error {
 	baseDir := resolveBaseDir()
 	trackersDir := filepath.Join(baseDir, "trackers")
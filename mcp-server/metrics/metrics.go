@@ -0,0 +1,120 @@
+// Package metrics exposes Prometheus counters/gauges for the pipeline so
+// operators can watch throughput and error rates instead of relying on
+// ad-hoc summary strings returned from MCP tool calls.
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	ToolCallsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "jobworkflow_tool_calls_total",
+		Help: "Total MCP tool invocations, labeled by tool name and result (ok/error).",
+	}, []string{"tool", "result"})
+
+	ClassifyDecisionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "jobworkflow_classify_decisions_total",
+		Help: "Total classifier decisions, labeled by decision (shortlist/reviewed/reject).",
+	}, []string{"decision"})
+
+	JobsByStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "jobworkflow_jobs_by_status",
+		Help: "Number of captured jobs per status, refreshed periodically from SQLite.",
+	}, []string{"status"})
+
+	TrackerWritesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "jobworkflow_tracker_writes_total",
+		Help: "Total Obsidian tracker files written.",
+	})
+
+	LatexCompileSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "jobworkflow_latex_compile_seconds",
+		Help:    "Time spent compiling resume.tex with pdflatex.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	DBOpenErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "jobworkflow_db_open_errors_total",
+		Help: "Total failures to open the capture SQLite DB.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		ToolCallsTotal,
+		ClassifyDecisionsTotal,
+		JobsByStatus,
+		TrackerWritesTotal,
+		LatexCompileSeconds,
+		DBOpenErrorsTotal,
+	)
+}
+
+// RecordToolCall increments ToolCallsTotal for a tool invocation. result
+// should be "ok" or "error".
+func RecordToolCall(tool, result string) {
+	ToolCallsTotal.WithLabelValues(tool, result).Inc()
+}
+
+// RecordClassifyDecision increments ClassifyDecisionsTotal for a
+// classifier decision (shortlist/reviewed/reject).
+func RecordClassifyDecision(decision string) {
+	ClassifyDecisionsTotal.WithLabelValues(decision).Inc()
+}
+
+// RecordTrackerWrite increments TrackerWritesTotal.
+func RecordTrackerWrite() {
+	TrackerWritesTotal.Inc()
+}
+
+// ObserveLatexCompile records how long a pdflatex compile took.
+func ObserveLatexCompile(d time.Duration) {
+	LatexCompileSeconds.Observe(d.Seconds())
+}
+
+// RecordDBOpenError increments DBOpenErrorsTotal.
+func RecordDBOpenError() {
+	DBOpenErrorsTotal.Inc()
+}
+
+// RefreshJobsByStatus recomputes JobsByStatus from the jobs table.
+func RefreshJobsByStatus(db *sql.DB) error {
+	rows, err := db.Query(`SELECT status, COUNT(1) FROM jobs GROUP BY status`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	JobsByStatus.Reset()
+	for rows.Next() {
+		var status string
+		var count float64
+		if err := rows.Scan(&status, &count); err != nil {
+			continue
+		}
+		JobsByStatus.WithLabelValues(status).Set(count)
+	}
+	return rows.Err()
+}
+
+// StartJobsByStatusRefresher refreshes JobsByStatus on a tick until ctx is
+// canceled. Refresh errors are ignored; they will be retried next tick.
+func StartJobsByStatusRefresher(ctx context.Context, db *sql.DB, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = RefreshJobsByStatus(db)
+			}
+		}
+	}()
+}
@@ -0,0 +1,15 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Serve starts a blocking HTTP server exposing /metrics. Callers should
+// run it in its own goroutine alongside MCP stdio serving.
+func Serve(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return http.ListenAndServe(addr, mux)
+}
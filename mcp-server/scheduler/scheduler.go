@@ -0,0 +1,220 @@
+// Package scheduler runs named, recurring background tasks alongside the
+// MCP stdio server, persisting run state in the same SQLite DB the rest
+// of the module already uses.
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Task is a named, recurring unit of work. Run must be safe to call
+// repeatedly (idempotent) and must return promptly when ctx is canceled.
+type Task interface {
+	Name() string
+	Interval() time.Duration
+	Run(ctx context.Context) (summary string, err error)
+}
+
+// State is the persisted run state of a registered task.
+type State struct {
+	Name       string
+	Interval   time.Duration
+	LastRun    sql.NullTime
+	LastStatus string
+	LastError  string
+	NextRun    time.Time
+}
+
+// Scheduler owns a set of registered tasks and the SQLite table tracking
+// their run state. A task currently executing is skipped on subsequent
+// ticks until it finishes, so overlapping runs never stack up.
+type Scheduler struct {
+	db    *sql.DB
+	tasks map[string]Task
+
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+func New(db *sql.DB) *Scheduler {
+	return &Scheduler{
+		db:      db,
+		tasks:   make(map[string]Task),
+		running: make(map[string]bool),
+	}
+}
+
+// EnsureSchema creates the scheduled_tasks table if it does not already
+// exist. Safe to call on every startup.
+func (s *Scheduler) EnsureSchema() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS scheduled_tasks (
+			name             TEXT PRIMARY KEY,
+			interval_seconds INTEGER NOT NULL,
+			last_run         TIMESTAMP,
+			last_status      TEXT NOT NULL DEFAULT '',
+			last_error       TEXT NOT NULL DEFAULT '',
+			next_run         TIMESTAMP NOT NULL
+		)
+	`)
+	return err
+}
+
+// Register adds a task and, if it has no persisted state yet, schedules
+// its first run immediately.
+func (s *Scheduler) Register(t Task) error {
+	s.mu.Lock()
+	s.tasks[t.Name()] = t
+	s.mu.Unlock()
+
+	_, err := s.db.Exec(`
+		INSERT INTO scheduled_tasks (name, interval_seconds, next_run)
+		VALUES (?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET interval_seconds = excluded.interval_seconds
+	`, t.Name(), int64(t.Interval().Seconds()), time.Now().UTC())
+	return err
+}
+
+// Unregister stops a task from being scheduled and removes its state.
+func (s *Scheduler) Unregister(name string) error {
+	s.mu.Lock()
+	delete(s.tasks, name)
+	s.mu.Unlock()
+
+	_, err := s.db.Exec(`DELETE FROM scheduled_tasks WHERE name = ?`, name)
+	return err
+}
+
+// List returns the persisted state of every registered task.
+func (s *Scheduler) List() ([]State, error) {
+	rows, err := s.db.Query(`
+		SELECT name, interval_seconds, last_run, last_status, last_error, next_run
+		FROM scheduled_tasks ORDER BY name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []State
+	for rows.Next() {
+		var st State
+		var intervalSeconds int64
+		if err := rows.Scan(&st.Name, &intervalSeconds, &st.LastRun, &st.LastStatus, &st.LastError, &st.NextRun); err != nil {
+			continue
+		}
+		st.Interval = time.Duration(intervalSeconds) * time.Second
+		out = append(out, st)
+	}
+	return out, rows.Err()
+}
+
+// RunNow executes a registered task immediately, regardless of its
+// schedule, unless it is already running.
+func (s *Scheduler) RunNow(ctx context.Context, name string) (string, error) {
+	s.mu.Lock()
+	t, ok := s.tasks[name]
+	if !ok {
+		s.mu.Unlock()
+		return "", fmt.Errorf("no task registered with name %q", name)
+	}
+	if s.running[name] {
+		s.mu.Unlock()
+		return "", fmt.Errorf("task %q is already running", name)
+	}
+	s.running[name] = true
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.running[name] = false
+		s.mu.Unlock()
+	}()
+
+	return s.runAndPersist(ctx, t)
+}
+
+// Start runs the scheduling loop until ctx is canceled, polling for due
+// tasks once per tick.
+func (s *Scheduler) Start(ctx context.Context, tick time.Duration) {
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runDue(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) runDue(ctx context.Context) {
+	s.mu.Lock()
+	names := make([]string, 0, len(s.tasks))
+	for name := range s.tasks {
+		if s.running[name] {
+			continue
+		}
+		names = append(names, name)
+	}
+	s.mu.Unlock()
+
+	for _, name := range names {
+		due, err := s.isDue(name)
+		if err != nil || !due {
+			continue
+		}
+		s.mu.Lock()
+		if s.running[name] {
+			s.mu.Unlock()
+			continue
+		}
+		s.running[name] = true
+		t := s.tasks[name]
+		s.mu.Unlock()
+
+		go func(t Task) {
+			defer func() {
+				s.mu.Lock()
+				s.running[t.Name()] = false
+				s.mu.Unlock()
+			}()
+			_, _ = s.runAndPersist(ctx, t)
+		}(t)
+	}
+}
+
+func (s *Scheduler) isDue(name string) (bool, error) {
+	row := s.db.QueryRow(`SELECT next_run FROM scheduled_tasks WHERE name = ?`, name)
+	var nextRun time.Time
+	if err := row.Scan(&nextRun); err != nil {
+		return false, err
+	}
+	return !time.Now().UTC().Before(nextRun), nil
+}
+
+func (s *Scheduler) runAndPersist(ctx context.Context, t Task) (string, error) {
+	summary, err := t.Run(ctx)
+	now := time.Now().UTC()
+	status := "ok"
+	errText := ""
+	if err != nil {
+		status = "error"
+		errText = err.Error()
+	}
+	_, updateErr := s.db.Exec(`
+		UPDATE scheduled_tasks
+		SET last_run = ?, last_status = ?, last_error = ?, next_run = ?
+		WHERE name = ?
+	`, now, status, errText, now.Add(t.Interval()), t.Name())
+	if updateErr != nil && err == nil {
+		err = updateErr
+	}
+	return summary, err
+}
@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeTask lets tests control how long Run blocks without needing a real
+// DB, since RunNow's "unknown task"/"already running" guards are checked
+// before any SQL runs.
+type fakeTask struct {
+	name    string
+	started chan struct{}
+	release chan struct{}
+}
+
+func (t *fakeTask) Name() string            { return t.name }
+func (t *fakeTask) Interval() time.Duration { return time.Minute }
+func (t *fakeTask) Run(ctx context.Context) (string, error) {
+	if t.started != nil {
+		close(t.started)
+	}
+	if t.release != nil {
+		<-t.release
+	}
+	return "ok", nil
+}
+
+func TestRunNowUnknownTask(t *testing.T) {
+	s := New(nil)
+	if _, err := s.RunNow(context.Background(), "nope"); err == nil {
+		t.Error("expected error for unregistered task, got nil")
+	}
+}
+
+func TestRunNowRejectsConcurrentRun(t *testing.T) {
+	s := New(nil)
+	task := &fakeTask{name: "slow", started: make(chan struct{}), release: make(chan struct{})}
+	s.tasks[task.Name()] = task
+
+	go func() {
+		s.mu.Lock()
+		s.running[task.Name()] = true
+		s.mu.Unlock()
+	}()
+
+	// Give the goroutine above a chance to mark the task running; RunNow
+	// must then reject a concurrent call without touching the DB.
+	for {
+		s.mu.Lock()
+		running := s.running[task.Name()]
+		s.mu.Unlock()
+		if running {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if _, err := s.RunNow(context.Background(), task.Name()); err == nil {
+		t.Error("expected error for already-running task, got nil")
+	}
+}
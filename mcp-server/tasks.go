@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/classifier"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/followups"
+)
+
+// AutoFilterTask wraps the auto_filter_jobs pipeline step so it can run on
+// a schedule instead of only when an MCP client calls the tool.
+type AutoFilterTask struct {
+	DB                *sql.DB
+	Classifier        config.ClassifierConfig
+	SourceStatus      string
+	Limit             int
+	ShortlistStatus   string
+	ReviewedStatus    string
+	RejectStatus      string
+	RequireLocation   bool
+	RequireProduction bool
+}
+
+func (t *AutoFilterTask) Name() string            { return "auto_filter_jobs" }
+func (t *AutoFilterTask) Interval() time.Duration { return 15 * time.Minute }
+
+func (t *AutoFilterTask) Run(ctx context.Context) (string, error) {
+	jobs, err := fetchJobsByStatus(t.DB, t.SourceStatus, t.Limit)
+	if err != nil {
+		return "", err
+	}
+	rules := &classifier.RuleClassifier{Config: t.Classifier, RequireLocation: t.RequireLocation, RequireProduction: t.RequireProduction}
+
+	var shortlisted, reviewed, rejected int
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return fmt.Sprintf("shortlisted=%d reviewed=%d rejected=%d (canceled)", shortlisted, reviewed, rejected), ctx.Err()
+		}
+		decision, err := rules.Classify(toClassifierJob(job))
+		if err != nil {
+			continue
+		}
+		switch decision {
+		case classifier.DecisionShortlist:
+			if _, err := runInitializationWithJob(job.Company, job.Title, job.URL, job.Description, job.JobID); err == nil {
+				_ = updateJobStatus(t.DB, job.URL, t.ShortlistStatus)
+				shortlisted++
+			}
+		case classifier.DecisionReviewed:
+			_ = updateJobStatus(t.DB, job.URL, t.ReviewedStatus)
+			reviewed++
+		default:
+			_ = updateJobStatus(t.DB, job.URL, t.RejectStatus)
+			rejected++
+		}
+	}
+	return fmt.Sprintf("shortlisted=%d reviewed=%d rejected=%d", shortlisted, reviewed, rejected), nil
+}
+
+// BatchInitializeTask wraps the initialize_jobs_batch pipeline step.
+type BatchInitializeTask struct {
+	DB         *sql.DB
+	Status     string
+	Limit      int
+	MarkStatus string
+}
+
+func (t *BatchInitializeTask) Name() string            { return "initialize_jobs_batch" }
+func (t *BatchInitializeTask) Interval() time.Duration { return 30 * time.Minute }
+
+func (t *BatchInitializeTask) Run(ctx context.Context) (string, error) {
+	jobs, err := fetchJobsByStatus(t.DB, t.Status, t.Limit)
+	if err != nil {
+		return "", err
+	}
+	var created int
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return fmt.Sprintf("initialized=%d (canceled)", created), ctx.Err()
+		}
+		if _, err := runInitializationWithJob(job.Company, job.Title, job.URL, job.Description, job.JobID); err != nil {
+			continue
+		}
+		if err := updateJobStatus(t.DB, job.URL, t.MarkStatus); err != nil {
+			continue
+		}
+		created++
+	}
+	return fmt.Sprintf("initialized=%d", created), nil
+}
+
+// StatusPromotionTask wraps the update_jobs_status pipeline step so a
+// status transition (e.g. new -> reviewed) can be applied periodically.
+type StatusPromotionTask struct {
+	DB         *sql.DB
+	FromStatus string
+	ToStatus   string
+	Limit      int
+}
+
+func (t *StatusPromotionTask) Name() string {
+	return "status_promotion_" + t.FromStatus + "_" + t.ToStatus
+}
+func (t *StatusPromotionTask) Interval() time.Duration { return time.Hour }
+
+func (t *StatusPromotionTask) Run(ctx context.Context) (string, error) {
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+	count, err := updateJobsStatus(t.DB, t.FromStatus, t.ToStatus, t.Limit, false)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("promoted=%d (%s -> %s)", count, t.FromStatus, t.ToStatus), nil
+}
+
+// followupPayload is the JSON shape stored in a job_followups row scheduled
+// via the schedule_followup tool.
+type followupPayload struct {
+	TrackerPath  string `json:"tracker_path"`
+	TargetStatus string `json:"target_status"`
+	JobURL       string `json:"job_url"`
+}
+
+// FollowupTask claims due one-off follow-ups (queued via schedule_followup)
+// and applies the tracker/job status transition each one describes.
+type FollowupTask struct {
+	DB    *sql.DB
+	Store *followups.Store
+}
+
+func (t *FollowupTask) Name() string            { return "process_followups" }
+func (t *FollowupTask) Interval() time.Duration { return 10 * time.Minute }
+
+func (t *FollowupTask) Run(ctx context.Context) (string, error) {
+	due, err := t.Store.ClaimDue(time.Now(), 20)
+	if err != nil {
+		return "", err
+	}
+
+	var applied, failed int
+	for _, f := range due {
+		if ctx.Err() != nil {
+			return fmt.Sprintf("applied=%d failed=%d (canceled)", applied, failed), ctx.Err()
+		}
+		var payload followupPayload
+		if err := json.Unmarshal([]byte(f.PayloadJSON), &payload); err != nil {
+			_ = t.Store.Complete(f.ID, err.Error())
+			failed++
+			continue
+		}
+		if _, _, err := updateTrackerStatus(payload.TrackerPath, payload.TargetStatus, false); err != nil {
+			_ = t.Store.Complete(f.ID, err.Error())
+			failed++
+			continue
+		}
+		if payload.JobURL != "" {
+			_ = updateJobStatus(t.DB, payload.JobURL, payload.TargetStatus)
+		}
+		_ = t.Store.Complete(f.ID, "")
+		applied++
+	}
+	return fmt.Sprintf("applied=%d failed=%d", applied, failed), nil
+}
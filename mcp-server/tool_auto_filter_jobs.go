@@ -2,14 +2,21 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/classifier"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/metrics"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/vectorstore"
 )
 
-func registerAutoFilterJobs(s *server.MCPServer) {
+func registerAutoFilterJobs(s *server.MCPServer, cfg config.ProgramConfig) {
 	filterTool := mcp.NewTool("auto_filter_jobs",
 		mcp.WithDescription("Auto-filter jobs (AI-focused) and update DB status; optionally write Obsidian trackers for shortlist"),
 	)
@@ -26,6 +33,7 @@ func registerAutoFilterJobs(s *server.MCPServer) {
 			"db_path":            map[string]interface{}{"type": "string", "description": "Override DB path (optional)"},
 			"require_location":   map[string]interface{}{"type": "boolean", "description": "If true, downgrade non-Ontario/remote to reviewed (default: false)"},
 			"require_production": map[string]interface{}{"type": "boolean", "description": "If true, require production/deployment signals (default: true)"},
+			"classifier":         map[string]interface{}{"type": "string", "description": "rules|embedding|hybrid (default: rules)"},
 		},
 	}
 	s.AddTool(filterTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -62,7 +70,7 @@ func registerAutoFilterJobs(s *server.MCPServer) {
 		if v, ok := args["reject_status"].(string); ok && strings.TrimSpace(v) != "" {
 			rejectStatus = strings.TrimSpace(v)
 		}
-		dbPath := ""
+		dbPath := cfg.DBPath
 		if v, ok := args["db_path"].(string); ok && strings.TrimSpace(v) != "" {
 			dbPath = strings.TrimSpace(v)
 		}
@@ -74,18 +82,32 @@ func registerAutoFilterJobs(s *server.MCPServer) {
 		if v, ok := args["require_production"].(bool); ok {
 			requireProduction = v
 		}
+		classifierMode := "rules"
+		if v, ok := args["classifier"].(string); ok && strings.TrimSpace(v) != "" {
+			classifierMode = strings.TrimSpace(v)
+		}
 
 		db, err := openCaptureDB(dbPath)
 		if err != nil {
+			metrics.RecordDBOpenError()
+			metrics.RecordToolCall("auto_filter_jobs", "error")
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to open capture DB: %v", err)), nil
 		}
 		defer db.Close()
 
+		cls, err := buildClassifier(db, classifierMode, requireLocation, requireProduction, cfg.Classifier)
+		if err != nil {
+			metrics.RecordToolCall("auto_filter_jobs", "error")
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to build classifier: %v", err)), nil
+		}
+
 		jobs, err := fetchJobsByStatus(db, sourceStatus, limit)
 		if err != nil {
+			metrics.RecordToolCall("auto_filter_jobs", "error")
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to query jobs: %v", err)), nil
 		}
 		if len(jobs) == 0 {
+			metrics.RecordToolCall("auto_filter_jobs", "ok")
 			return mcp.NewToolResultText("No jobs found to filter."), nil
 		}
 
@@ -95,7 +117,12 @@ func registerAutoFilterJobs(s *server.MCPServer) {
 		var failed int
 
 		for _, job := range jobs {
-			decision := classifyJob(job, requireLocation, requireProduction)
+			decision, err := cls.Classify(toClassifierJob(job))
+			if err != nil {
+				failed++
+				continue
+			}
+			metrics.RecordClassifyDecision(decision)
 			if dryRun {
 				switch decision {
 				case "shortlist":
@@ -115,6 +142,7 @@ func registerAutoFilterJobs(s *server.MCPServer) {
 						failed++
 						continue
 					}
+					metrics.RecordTrackerWrite()
 				}
 				if err := updateJobStatus(db, job.URL, shortlistStatus); err != nil {
 					failed++
@@ -142,6 +170,52 @@ func registerAutoFilterJobs(s *server.MCPServer) {
 		if failed > 0 {
 			summary.WriteString(fmt.Sprintf("Failed: %d\n", failed))
 		}
+		metrics.RecordToolCall("auto_filter_jobs", "ok")
 		return mcp.NewToolResultText(summary.String()), nil
 	})
 }
+
+// buildClassifier constructs the classifier named by mode ("rules",
+// "embedding" or "hybrid"). "embedding" and "hybrid" both fall back to the
+// rule classifier when anchors are missing or scores are too close to call;
+// "hybrid" is an alias kept for readability in tool arguments.
+func buildClassifier(db *sql.DB, mode string, requireLocation, requireProduction bool, clsCfg config.ClassifierConfig) (classifier.Classifier, error) {
+	rules := &classifier.RuleClassifier{
+		Config:            clsCfg,
+		RequireLocation:   requireLocation,
+		RequireProduction: requireProduction,
+	}
+
+	switch mode {
+	case "", "rules":
+		return rules, nil
+	case "embedding", "hybrid":
+		store := vectorstore.NewStore(db)
+		if err := store.EnsureSchema(); err != nil {
+			return nil, fmt.Errorf("ensure embeddings schema: %w", err)
+		}
+		embedder := defaultEmbedder()
+		return &classifier.EmbeddingClassifier{
+			Store:    store,
+			Embedder: embedder,
+			K:        3,
+			Margin:   0.02,
+			Fallback: rules,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown classifier mode %q", mode)
+	}
+}
+
+func defaultEmbedder() vectorstore.Embedder {
+	endpoint := os.Getenv("JOBWORKFLOW_EMBED_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "http://localhost:11434/api/embeddings"
+	}
+	model := os.Getenv("JOBWORKFLOW_EMBED_MODEL")
+	if model == "" {
+		model = "nomic-embed-text"
+	}
+	apiKey := os.Getenv("JOBWORKFLOW_EMBED_API_KEY")
+	return vectorstore.NewHTTPEmbedder(endpoint, model, apiKey)
+}
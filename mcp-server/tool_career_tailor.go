@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/metrics"
 )
 
-func registerCareerTailor(s *server.MCPServer) {
+func registerCareerTailor(s *server.MCPServer, cfg config.ProgramConfig) {
 	careerTool := mcp.NewTool("career_tailor",
 		mcp.WithDescription("Create per-job resume workspace from a tracker and optionally compile LaTeX"),
 	)
@@ -41,15 +45,21 @@ func registerCareerTailor(s *server.MCPServer) {
 		if v, ok := args["force"].(bool); ok {
 			force = v
 		}
-		pdflatex := ""
+		pdflatex := cfg.PdflatexPath
 		if v, ok := args["pdflatex"].(string); ok && strings.TrimSpace(v) != "" {
 			pdflatex = strings.TrimSpace(v)
 		}
 
+		start := time.Now()
 		result, err := runCareerTailor(trackerPath, compile, force, pdflatex)
+		if compile {
+			metrics.ObserveLatexCompile(time.Since(start))
+		}
 		if err != nil {
+			metrics.RecordToolCall("career_tailor", "error")
 			return mcp.NewToolResultError(fmt.Sprintf("career_tailor failed: %v", err)), nil
 		}
+		metrics.RecordToolCall("career_tailor", "ok")
 		return mcp.NewToolResultText(result), nil
 	})
 }
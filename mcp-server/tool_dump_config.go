@@ -0,0 +1,25 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+)
+
+func registerDumpConfig(s *server.MCPServer, cfg config.ProgramConfig) {
+	tool := mcp.NewTool("dump_config",
+		mcp.WithDescription("Return the effective merged ProgramConfig (defaults overlaid with config.yaml/config.json)"),
+	)
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		out, err := json.MarshalIndent(cfg, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal config: %v", err)), nil
+		}
+		return mcp.NewToolResultText(string(out)), nil
+	})
+}
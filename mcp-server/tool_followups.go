@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/followups"
+)
+
+// buildFollowupStore opens the job_followups table against db, creating it
+// if needed. Safe to call on every startup.
+func buildFollowupStore(db *sql.DB) (*followups.Store, error) {
+	store := followups.New(db)
+	if err := store.EnsureSchema(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func registerFollowupTools(s *server.MCPServer, store *followups.Store) {
+	scheduleTool := mcp.NewTool("schedule_followup",
+		mcp.WithDescription("Queue a tracker status transition to apply automatically after a delay (e.g. 'ping me about this job in 7 days')"),
+	)
+	scheduleTool.InputSchema = mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"tracker_path":  map[string]interface{}{"type": "string", "description": "Path to tracker markdown file"},
+			"target_status": map[string]interface{}{"type": "string", "description": "Status to set on the tracker (and job, if job_url is given) when the follow-up fires"},
+			"job_url":       map[string]interface{}{"type": "string", "description": "Optional capture DB job URL to also transition"},
+			"run_in_days":   map[string]interface{}{"type": "number", "description": "Days from now to run the follow-up"},
+		},
+		Required: []string{"tracker_path", "target_status", "run_in_days"},
+	}
+	s.AddTool(scheduleTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := request.Params.Arguments.(map[string]interface{})
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments format"), nil
+		}
+		trackerPath, _ := args["tracker_path"].(string)
+		targetStatus, _ := args["target_status"].(string)
+		jobURL, _ := args["job_url"].(string)
+		runInDays, _ := args["run_in_days"].(float64)
+		if strings.TrimSpace(trackerPath) == "" || strings.TrimSpace(targetStatus) == "" {
+			return mcp.NewToolResultError("tracker_path and target_status are required"), nil
+		}
+
+		payload, err := json.Marshal(followupPayload{TrackerPath: trackerPath, TargetStatus: targetStatus, JobURL: jobURL})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("schedule_followup failed: %v", err)), nil
+		}
+		runAt := time.Now().Add(time.Duration(runInDays * float64(24*time.Hour)))
+		id, err := store.Schedule("tracker_status_transition", runAt, string(payload))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("schedule_followup failed: %v", err)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Scheduled follow-up #%d for %s at %s.", id, trackerPath, runAt.Format("2006-01-02 15:04:05"))), nil
+	})
+
+	listTool := mcp.NewTool("list_followups",
+		mcp.WithDescription("List queued and recently applied follow-ups"),
+	)
+	s.AddTool(listTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		all, err := store.List(50)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("list_followups failed: %v", err)), nil
+		}
+		if len(all) == 0 {
+			return mcp.NewToolResultText("No follow-ups queued."), nil
+		}
+		var b strings.Builder
+		for _, f := range all {
+			b.WriteString(fmt.Sprintf("#%d [%s] %s next_run=%s", f.ID, f.Status, f.Kind, f.NextRunAt.Format("2006-01-02 15:04:05")))
+			if f.LastError != "" {
+				b.WriteString(" error=" + f.LastError)
+			}
+			b.WriteString("\n")
+		}
+		return mcp.NewToolResultText(b.String()), nil
+	})
+}
@@ -7,9 +7,12 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/metrics"
 )
 
-func registerInitializeJobsBatch(s *server.MCPServer) {
+func registerInitializeJobsBatch(s *server.MCPServer, cfg config.ProgramConfig) {
 	batchTool := mcp.NewTool("initialize_jobs_batch",
 		mcp.WithDescription("Batch initialize trackers from SQLite capture DB (status=new by default)"),
 	)
@@ -47,22 +50,26 @@ func registerInitializeJobsBatch(s *server.MCPServer) {
 		if v, ok := args["dry_run"].(bool); ok {
 			dryRun = v
 		}
-		dbPath := ""
+		dbPath := cfg.DBPath
 		if v, ok := args["db_path"].(string); ok && strings.TrimSpace(v) != "" {
 			dbPath = strings.TrimSpace(v)
 		}
 
 		db, err := openCaptureDB(dbPath)
 		if err != nil {
+			metrics.RecordDBOpenError()
+			metrics.RecordToolCall("initialize_jobs_batch", "error")
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to open capture DB: %v", err)), nil
 		}
 		defer db.Close()
 
 		jobs, err := fetchJobsByStatus(db, status, limit)
 		if err != nil {
+			metrics.RecordToolCall("initialize_jobs_batch", "error")
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to query jobs: %v", err)), nil
 		}
 		if len(jobs) == 0 {
+			metrics.RecordToolCall("initialize_jobs_batch", "ok")
 			return mcp.NewToolResultText("No jobs found for batch initialization."), nil
 		}
 
@@ -77,6 +84,7 @@ func registerInitializeJobsBatch(s *server.MCPServer) {
 				failed = append(failed, fmt.Sprintf("%s (%v)", job.URL, err))
 				continue
 			}
+			metrics.RecordTrackerWrite()
 			if err := updateJobStatus(db, job.URL, markStatus); err != nil {
 				failed = append(failed, fmt.Sprintf("%s (status update failed: %v)", job.URL, err))
 				continue
@@ -92,6 +100,7 @@ func registerInitializeJobsBatch(s *server.MCPServer) {
 		if len(failed) > 0 {
 			summary.WriteString(fmt.Sprintf("Failed: %d\n", len(failed)))
 		}
+		metrics.RecordToolCall("initialize_jobs_batch", "ok")
 		return mcp.NewToolResultText(summary.String()), nil
 	})
 }
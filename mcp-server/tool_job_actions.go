@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/actions"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/tracker"
+)
+
+func registerJobActions(s *server.MCPServer, cfg config.ProgramConfig) {
+	executor := actions.NewExecutor(cfg.Actions.Allowlist, time.Duration(cfg.Actions.TimeoutSeconds)*time.Second)
+
+	listTool := mcp.NewTool("list_job_actions",
+		mcp.WithDescription("List the actions declared in a tracker file's frontmatter"),
+	)
+	listTool.InputSchema = mcp.ToolInputSchema{
+		Type:       "object",
+		Properties: map[string]interface{}{"tracker_path": map[string]interface{}{"type": "string", "description": "Path to tracker markdown file"}},
+		Required:   []string{"tracker_path"},
+	}
+	s.AddTool(listTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := request.Params.Arguments.(map[string]interface{})
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments format"), nil
+		}
+		trackerPath, _ := args["tracker_path"].(string)
+		if strings.TrimSpace(trackerPath) == "" {
+			return mcp.NewToolResultError("tracker_path is required"), nil
+		}
+
+		declared, _, err := loadTrackerActions(trackerPath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("list_job_actions failed: %v", err)), nil
+		}
+		if len(declared) == 0 {
+			return mcp.NewToolResultText("No actions declared in this tracker."), nil
+		}
+		var b strings.Builder
+		for _, a := range declared {
+			b.WriteString(fmt.Sprintf("%s: %s\n", a.Name, a.Command))
+		}
+		return mcp.NewToolResultText(b.String()), nil
+	})
+
+	runTool := mcp.NewTool("run_job_action",
+		mcp.WithDescription("Execute a tracker-declared action and return its stdout/stderr/exit code"),
+	)
+	runTool.InputSchema = mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"tracker_path": map[string]interface{}{"type": "string", "description": "Path to tracker markdown file"},
+			"action":       map[string]interface{}{"type": "string", "description": "Name of the action to run"},
+		},
+		Required: []string{"tracker_path", "action"},
+	}
+	s.AddTool(runTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := request.Params.Arguments.(map[string]interface{})
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments format"), nil
+		}
+		trackerPath, _ := args["tracker_path"].(string)
+		actionName, _ := args["action"].(string)
+		if strings.TrimSpace(trackerPath) == "" || strings.TrimSpace(actionName) == "" {
+			return mcp.NewToolResultError("tracker_path and action are required"), nil
+		}
+
+		declared, data, err := loadTrackerActions(trackerPath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("run_job_action failed: %v", err)), nil
+		}
+		var action actions.Action
+		var found bool
+		for _, a := range declared {
+			if a.Name == actionName {
+				action, found = a, true
+				break
+			}
+		}
+		if !found {
+			return mcp.NewToolResultError(fmt.Sprintf("no action named %q declared in %s", actionName, trackerPath)), nil
+		}
+
+		result, err := executor.Run(ctx, action, data)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("action %q failed: %v\nstdout: %s\nstderr: %s", actionName, err, result.Stdout, result.Stderr)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("exit code: %d\nstdout: %s\nstderr: %s", result.ExitCode, result.Stdout, result.Stderr)), nil
+	})
+}
+
+// loadTrackerActions reads a tracker file's frontmatter, returning its
+// declared actions and the template data ({{.Company}} etc.) to run them
+// with.
+func loadTrackerActions(trackerPath string) ([]actions.Action, actions.TemplateData, error) {
+	path := expandHome(trackerPath)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(resolveBaseDir(), path)
+	}
+	doc, err := tracker.Load(path)
+	if err != nil {
+		return nil, actions.TemplateData{}, err
+	}
+
+	data := actions.TemplateData{
+		Company:    doc.Frontmatter.Company,
+		Position:   doc.Frontmatter.Position,
+		ResumePath: doc.Frontmatter.ResumePath,
+		URL:        doc.Frontmatter.ReferenceLink,
+	}
+
+	var declared []actions.Action
+	for name, command := range actionsFromExtra(doc.Frontmatter.Extra) {
+		declared = append(declared, actions.Action{Name: name, Command: command})
+	}
+	return declared, data, nil
+}
+
+// actionsFromExtra extracts the "actions:" frontmatter block into a
+// name->command map. "actions" is not a field tracker.Frontmatter
+// models, so it round-trips through Extra as a nested map.
+func actionsFromExtra(extra map[string]interface{}) map[string]string {
+	actionsMap := make(map[string]string)
+	raw, ok := extra["actions"].(map[string]interface{})
+	if !ok {
+		return actionsMap
+	}
+	for name, value := range raw {
+		if command, ok := value.(string); ok {
+			actionsMap[name] = command
+		}
+	}
+	return actionsMap
+}
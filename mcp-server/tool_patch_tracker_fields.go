@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/tracker"
+)
+
+// registerPatchTrackerFields registers patch_tracker_fields, a companion
+// to update_tracker_status for arbitrary key/value frontmatter edits
+// against the same typed tracker.Doc model.
+func registerPatchTrackerFields(s *server.MCPServer, cfg config.ProgramConfig) {
+	patchTool := mcp.NewTool("patch_tracker_fields",
+		mcp.WithDescription("Set arbitrary frontmatter fields on a tracker, preserving comments and key order"),
+	)
+	patchTool.InputSchema = mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"tracker_path": map[string]interface{}{"type": "string", "description": "Path to tracker markdown file"},
+			"fields":       map[string]interface{}{"type": "object", "description": "Frontmatter key/value pairs to set; a null value removes the key"},
+			"dry_run":      map[string]interface{}{"type": "boolean", "description": "If true, do not write file"},
+		},
+		Required: []string{"tracker_path", "fields"},
+	}
+	s.AddTool(patchTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := request.Params.Arguments.(map[string]interface{})
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments format"), nil
+		}
+		trackerPath, _ := args["tracker_path"].(string)
+		if strings.TrimSpace(trackerPath) == "" {
+			return mcp.NewToolResultError("tracker_path is required"), nil
+		}
+		fields, ok := args["fields"].(map[string]interface{})
+		if !ok || len(fields) == 0 {
+			return mcp.NewToolResultError("fields must be a non-empty object"), nil
+		}
+		dryRun := false
+		if v, ok := args["dry_run"].(bool); ok {
+			dryRun = v
+		}
+
+		absTracker := expandHome(trackerPath)
+		if !filepath.IsAbs(absTracker) {
+			absTracker = filepath.Join(resolveBaseDir(), absTracker)
+		}
+
+		doc, err := tracker.Load(absTracker)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("patch_tracker_fields failed: %v", err)), nil
+		}
+		for key, value := range fields {
+			if err := doc.PatchField(key, value); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("patch_tracker_fields failed to set %q: %v", key, err)), nil
+			}
+		}
+
+		if dryRun {
+			diff, err := doc.DryRun()
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("patch_tracker_fields failed: %v", err)), nil
+			}
+			if diff == "" {
+				return mcp.NewToolResultText(fmt.Sprintf("Dry run: no changes to %s", absTracker)), nil
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Dry run: would update %s\n%s", absTracker, diff)), nil
+		}
+
+		diff, err := doc.Save()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("patch_tracker_fields failed: %v", err)), nil
+		}
+		if diff == "" {
+			return mcp.NewToolResultText(fmt.Sprintf("No changes to %s", absTracker)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Updated %s\n%s", absTracker, diff)), nil
+	})
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/followups"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/scheduler"
+)
+
+// builtinTasks returns the fixed set of pipeline tasks the scheduler
+// knows how to run, with their default arguments. Both buildScheduler
+// (initial registration) and schedule_task (re-registration by name)
+// build from this same list, so there is exactly one place that knows
+// how to construct each built-in task.
+func builtinTasks(db *sql.DB, cfg config.ProgramConfig, followupStore *followups.Store) []scheduler.Task {
+	return []scheduler.Task{
+		&AutoFilterTask{DB: db, Classifier: cfg.Classifier, SourceStatus: "new", Limit: 50, ShortlistStatus: "shortlist", ReviewedStatus: "reviewed", RejectStatus: "reject", RequireProduction: true},
+		&BatchInitializeTask{DB: db, Status: "new", Limit: 50, MarkStatus: "tracked"},
+		&StatusPromotionTask{DB: db, FromStatus: "shortlist", ToStatus: "reviewed", Limit: 0},
+		&FollowupTask{DB: db, Store: followupStore},
+	}
+}
+
+// buildScheduler wires up the scheduler against db and registers the
+// built-in pipeline tasks with their default arguments. It is shared by
+// the --jobserver background worker and the schedule_* MCP tools so both
+// surfaces see the same task set.
+func buildScheduler(db *sql.DB, cfg config.ProgramConfig, followupStore *followups.Store) (*scheduler.Scheduler, error) {
+	sch := scheduler.New(db)
+	if err := sch.EnsureSchema(); err != nil {
+		return nil, err
+	}
+
+	for _, t := range builtinTasks(db, cfg, followupStore) {
+		if err := sch.Register(t); err != nil {
+			return nil, err
+		}
+	}
+	return sch, nil
+}
+
+func registerSchedulerTools(s *server.MCPServer, sch *scheduler.Scheduler, db *sql.DB, cfg config.ProgramConfig, followupStore *followups.Store) {
+	listTool := mcp.NewTool("list_scheduled_tasks",
+		mcp.WithDescription("List registered background tasks and their last/next run state"),
+	)
+	s.AddTool(listTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		states, err := sch.List()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list scheduled tasks: %v", err)), nil
+		}
+		if len(states) == 0 {
+			return mcp.NewToolResultText("No scheduled tasks registered."), nil
+		}
+		var b strings.Builder
+		for _, st := range states {
+			b.WriteString(fmt.Sprintf("%s: interval=%s last_status=%s next_run=%s\n", st.Name, st.Interval, st.LastStatus, st.NextRun.Format("2006-01-02 15:04:05")))
+		}
+		return mcp.NewToolResultText(b.String()), nil
+	})
+
+	runNowTool := mcp.NewTool("run_scheduled_task_now",
+		mcp.WithDescription("Run a registered background task immediately"),
+	)
+	runNowTool.InputSchema = mcp.ToolInputSchema{
+		Type:       "object",
+		Properties: map[string]interface{}{"name": map[string]interface{}{"type": "string", "description": "Task name"}},
+		Required:   []string{"name"},
+	}
+	s.AddTool(runNowTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := request.Params.Arguments.(map[string]interface{})
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments format"), nil
+		}
+		name, _ := args["name"].(string)
+		if strings.TrimSpace(name) == "" {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+		summary, err := sch.RunNow(ctx, name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("run_scheduled_task_now failed: %v", err)), nil
+		}
+		return mcp.NewToolResultText(summary), nil
+	})
+
+	unscheduleTool := mcp.NewTool("unschedule_task",
+		mcp.WithDescription("Stop a registered background task from running on its schedule"),
+	)
+	unscheduleTool.InputSchema = mcp.ToolInputSchema{
+		Type:       "object",
+		Properties: map[string]interface{}{"name": map[string]interface{}{"type": "string", "description": "Task name"}},
+		Required:   []string{"name"},
+	}
+	s.AddTool(unscheduleTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := request.Params.Arguments.(map[string]interface{})
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments format"), nil
+		}
+		name, _ := args["name"].(string)
+		if strings.TrimSpace(name) == "" {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+		if err := sch.Unregister(name); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("unschedule_task failed: %v", err)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Unscheduled %q.", name)), nil
+	})
+
+	scheduleTool := mcp.NewTool("schedule_task",
+		mcp.WithDescription("(Re-)register a built-in background task so it runs on its default interval"),
+	)
+	scheduleTool.InputSchema = mcp.ToolInputSchema{
+		Type:       "object",
+		Properties: map[string]interface{}{"name": map[string]interface{}{"type": "string", "description": "Task name"}},
+		Required:   []string{"name"},
+	}
+	s.AddTool(scheduleTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := request.Params.Arguments.(map[string]interface{})
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments format"), nil
+		}
+		name, _ := args["name"].(string)
+		if strings.TrimSpace(name) == "" {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+		states, err := sch.List()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to inspect scheduled tasks: %v", err)), nil
+		}
+		for _, st := range states {
+			if st.Name == name {
+				return mcp.NewToolResultText(fmt.Sprintf("%q is already scheduled.", name)), nil
+			}
+		}
+		for _, t := range builtinTasks(db, cfg, followupStore) {
+			if t.Name() == name {
+				if err := sch.Register(t); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("schedule_task failed: %v", err)), nil
+				}
+				return mcp.NewToolResultText(fmt.Sprintf("Scheduled %q.", name)), nil
+			}
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("unknown task %q; known tasks are registered at startup", name)), nil
+	})
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/metrics"
+)
+
+// registerSearchJobs registers search_jobs, a full-text search over captured
+// jobs backed by SQLite FTS5 (falling back to a LIKE scan when the SQLite
+// build lacks FTS5).
+func registerSearchJobs(s *server.MCPServer, cfg config.ProgramConfig) {
+	const toolName = "search_jobs"
+	searchTool := mcp.NewTool(toolName,
+		mcp.WithDescription("Full-text search over captured jobs (title, description, company, location)"),
+	)
+	searchTool.InputSchema = mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"query":     map[string]interface{}{"type": "string", "description": "Search text"},
+			"status":    map[string]interface{}{"type": "string", "description": "Optional status filter"},
+			"limit":     map[string]interface{}{"type": "number", "description": "Max results (default 20)"},
+			"highlight": map[string]interface{}{"type": "boolean", "description": "Wrap matches in [] in the returned snippets (default true; the LIKE fallback returns unmarked title/description)"},
+		},
+		Required: []string{"query"},
+	}
+	s.AddTool(searchTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := request.Params.Arguments.(map[string]interface{})
+		if !ok {
+			metrics.RecordToolCall(toolName, "error")
+			return mcp.NewToolResultError("invalid arguments format"), nil
+		}
+		query, _ := args["query"].(string)
+		query = strings.TrimSpace(query)
+		if query == "" {
+			metrics.RecordToolCall(toolName, "error")
+			return mcp.NewToolResultError("query is required"), nil
+		}
+		status := ""
+		if v, ok := args["status"].(string); ok {
+			status = strings.TrimSpace(v)
+		}
+		limit := 20
+		if v, ok := args["limit"].(float64); ok && v > 0 {
+			limit = int(v)
+		}
+		highlight := true
+		if v, ok := args["highlight"].(bool); ok {
+			highlight = v
+		}
+
+		db, err := openCaptureDB(cfg.DBPath)
+		if err != nil {
+			metrics.RecordDBOpenError()
+			metrics.RecordToolCall(toolName, "error")
+			return mcp.NewToolResultError(fmt.Sprintf("search_jobs failed: %v", err)), nil
+		}
+		defer db.Close()
+
+		results, err := searchJobs(db, query, status, limit, highlight)
+		if err != nil {
+			metrics.RecordToolCall(toolName, "error")
+			return mcp.NewToolResultError(fmt.Sprintf("search_jobs failed: %v", err)), nil
+		}
+
+		if len(results) == 0 {
+			metrics.RecordToolCall(toolName, "ok")
+			return mcp.NewToolResultText(fmt.Sprintf("No matches for %q", query)), nil
+		}
+
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "Found %d match(es) for %q:\n", len(results), query)
+		for _, r := range results {
+			fmt.Fprintf(&sb, "- [%s] %s @ %s (%s)\n  %s\n", r.Status, r.TitleSnippet, r.Company, r.URL, r.DescriptionSnippet)
+		}
+		metrics.RecordToolCall(toolName, "ok")
+		return mcp.NewToolResultText(sb.String()), nil
+	})
+}
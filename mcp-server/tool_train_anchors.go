@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/tracker"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/vectorstore"
+)
+
+// anchorLabels maps the tracker status values we recognize as anchor
+// examples onto the classifier decisions they represent.
+var anchorLabels = map[string]string{
+	"shortlist": "shortlist",
+	"reviewed":  "reviewed",
+	"reject":    "reject",
+}
+
+func registerTrainAnchors(s *server.MCPServer, cfg config.ProgramConfig) {
+	tool := mcp.NewTool("train_anchors",
+		mcp.WithDescription("Index tracker markdown files as labeled anchor examples for the embedding classifier"),
+	)
+	tool.InputSchema = mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"db_path": map[string]interface{}{"type": "string", "description": "Override DB path (optional)"},
+		},
+	}
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := request.Params.Arguments.(map[string]interface{})
+		dbPath := cfg.DBPath
+		if v, ok := args["db_path"].(string); ok && strings.TrimSpace(v) != "" {
+			dbPath = strings.TrimSpace(v)
+		}
+
+		db, err := openCaptureDB(dbPath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to open capture DB: %v", err)), nil
+		}
+		defer db.Close()
+
+		store := vectorstore.NewStore(db)
+		if err := store.EnsureSchema(); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to ensure embeddings schema: %v", err)), nil
+		}
+		embedder := defaultEmbedder()
+
+		trackersDir := cfg.TrackersDir
+		if trackersDir == "" {
+			trackersDir = filepath.Join(resolveBaseDir(), "trackers")
+		}
+		entries, err := os.ReadDir(trackersDir)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read trackers dir: %v", err)), nil
+		}
+
+		var indexed int
+		var skipped int
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+				continue
+			}
+			path := filepath.Join(trackersDir, entry.Name())
+			label, jd, err := anchorFromTracker(path)
+			if err != nil || label == "" {
+				skipped++
+				continue
+			}
+			vec, err := embedder.Embed(ctx, jd)
+			if err != nil {
+				skipped++
+				continue
+			}
+			if err := store.Put(path, label, embedder.Model(), vec); err != nil {
+				skipped++
+				continue
+			}
+			indexed++
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Indexed %d anchor examples (skipped %d).", indexed, skipped)), nil
+	})
+}
+
+// anchorFromTracker reads a tracker file's frontmatter status and its "##
+// Job Description" section, returning the anchor label (empty if the
+// status doesn't map to shortlist/reviewed/reject) and the text to embed.
+func anchorFromTracker(path string) (label, jd string, err error) {
+	doc, err := tracker.Load(path)
+	if err != nil {
+		return "", "", err
+	}
+	status := strings.ToLower(strings.TrimSpace(doc.Frontmatter.Status))
+	label = anchorLabels[status]
+
+	const marker = "## Job Description"
+	if idx := strings.Index(doc.Body, marker); idx != -1 {
+		rest := doc.Body[idx+len(marker):]
+		if end := strings.Index(rest, "## "); end != -1 {
+			rest = rest[:end]
+		}
+		jd = strings.TrimSpace(rest)
+	}
+	return label, jd, nil
+}
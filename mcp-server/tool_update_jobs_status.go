@@ -7,9 +7,12 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/metrics"
 )
 
-func registerUpdateJobsStatus(s *server.MCPServer) {
+func registerUpdateJobsStatus(s *server.MCPServer, cfg config.ProgramConfig) {
 	statusTool := mcp.NewTool("update_jobs_status",
 		mcp.WithDescription("Update job status in SQLite (defaults: new -> review)"),
 	)
@@ -45,21 +48,25 @@ func registerUpdateJobsStatus(s *server.MCPServer) {
 		if v, ok := args["dry_run"].(bool); ok {
 			dryRun = v
 		}
-		dbPath := ""
+		dbPath := cfg.DBPath
 		if v, ok := args["db_path"].(string); ok && strings.TrimSpace(v) != "" {
 			dbPath = strings.TrimSpace(v)
 		}
 
 		db, err := openCaptureDB(dbPath)
 		if err != nil {
+			metrics.RecordDBOpenError()
+			metrics.RecordToolCall("update_jobs_status", "error")
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to open capture DB: %v", err)), nil
 		}
 		defer db.Close()
 
 		count, err := updateJobsStatus(db, fromStatus, toStatus, limit, dryRun)
 		if err != nil {
+			metrics.RecordToolCall("update_jobs_status", "error")
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to update jobs: %v", err)), nil
 		}
+		metrics.RecordToolCall("update_jobs_status", "ok")
 		return mcp.NewToolResultText(fmt.Sprintf("Updated %d jobs (%s -> %s, dry_run=%v).", count, fromStatus, toStatus, dryRun)), nil
 	})
 }
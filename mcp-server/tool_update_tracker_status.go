@@ -7,9 +7,11 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/config"
 )
 
-func registerUpdateTrackerStatus(s *server.MCPServer) {
+func registerUpdateTrackerStatus(s *server.MCPServer, cfg config.ProgramConfig) {
 	trackerStatusTool := mcp.NewTool("update_tracker_status",
 		mcp.WithDescription("Update tracker frontmatter status (default: Resume Written)"),
 	)
@@ -40,12 +42,15 @@ func registerUpdateTrackerStatus(s *server.MCPServer) {
 			dryRun = v
 		}
 
-		updatedPath, err := updateTrackerStatus(trackerPath, status, dryRun)
+		updatedPath, diff, err := updateTrackerStatus(trackerPath, status, dryRun)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("update_tracker_status failed: %v", err)), nil
 		}
 		if dryRun {
-			return mcp.NewToolResultText(fmt.Sprintf("Dry run: would update status to %q in %s", status, updatedPath)), nil
+			if diff == "" {
+				return mcp.NewToolResultText(fmt.Sprintf("Dry run: status is already %q in %s", status, updatedPath)), nil
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Dry run: would update status to %q in %s\n%s", status, updatedPath, diff)), nil
 		}
 		return mcp.NewToolResultText(fmt.Sprintf("Updated status to %q in %s", status, updatedPath)), nil
 	})
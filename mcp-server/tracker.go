@@ -1,11 +1,16 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/DengNaichen/JobWorkFlow/mcp-server/events"
+	trackerpkg "github.com/DengNaichen/JobWorkFlow/mcp-server/tracker"
 )
 
 func runInitializationWithJob(company, position, url, jd, jobID string) (string, error) {
@@ -38,28 +43,28 @@ func runInitializationWithJob(company, position, url, jd, jobID string) (string,
 		displayJD = "(no description)"
 	}
 
-	content := fmt.Sprintf(`---
-company: %s
-position: %s
-status: Reviewed
-next_action:
-  - Wait for feedback
-salary: 0
-application_date: %s
-website: 
-reference_link: %s
-resume_path: "[[data/applications/%s/resume/resume.pdf]]"
-cover_letter_path: "[[data/applications/%s/cover/cover-letter.pdf]]"
----
-
-## Job Description
-%s
-
-## Notes
-- Created via Kaka Go MCP Tool
-`, displayCompany, displayPosition, dateStr, url, filepath.Base(appDir), filepath.Base(appDir), displayJD)
-
-	return filePath, os.WriteFile(filePath, []byte(content), 0644)
+	fm := trackerpkg.Frontmatter{
+		Company:         displayCompany,
+		Position:        displayPosition,
+		Status:          "Reviewed",
+		NextAction:      []string{"Wait for feedback"},
+		Salary:          0,
+		ApplicationDate: dateStr,
+		ReferenceLink:   url,
+		ResumePath:      fmt.Sprintf("[[data/applications/%s/resume/resume.pdf]]", filepath.Base(appDir)),
+		CoverLetterPath: fmt.Sprintf("[[data/applications/%s/cover/cover-letter.pdf]]", filepath.Base(appDir)),
+	}
+	body := fmt.Sprintf("\n\n## Job Description\n%s\n\n## Notes\n- Created via Kaka Go MCP Tool\n", displayJD)
+
+	doc, err := trackerpkg.New(filePath, fm, body)
+	if err != nil {
+		return "", err
+	}
+	if _, err := doc.Save(); err != nil {
+		return "", err
+	}
+	events.PublishTrackerCreated(slug, displayCompany, displayPosition, filePath)
+	return filePath, nil
 }
 
 func trackerSlug(company, jobID, url, title string) string {
@@ -78,6 +83,17 @@ func trackerSlug(company, jobID, url, title string) string {
 	return "job_unknown"
 }
 
+// trackerSlugFromPath derives an events slug from a tracker file's name
+// (e.g. "2026-07-29-acme.md" -> "acme"), for trackers updated directly by
+// path rather than created through runInitializationWithJob.
+func trackerSlugFromPath(path string) string {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if len(base) > 11 && base[4] == '-' && base[7] == '-' && base[10] == '-' {
+		return base[11:]
+	}
+	return base
+}
+
 func uniqueFilePath(dir, filename string) string {
 	path := filepath.Join(dir, filename)
 	if _, err := os.Stat(path); err != nil {
@@ -94,7 +110,11 @@ func uniqueFilePath(dir, filename string) string {
 	return path
 }
 
-func updateTrackerStatus(trackerPath, status string, dryRun bool) (string, error) {
+// updateTrackerStatus loads a tracker, mutates its status field, and
+// saves it back (preserving comments, key order, and the body
+// untouched). When dryRun is true, nothing is written and diff describes
+// what would have changed.
+func updateTrackerStatus(trackerPath, status string, dryRun bool) (path string, diff string, err error) {
 	baseDir := resolveBaseDir()
 	trackerPath = expandHome(trackerPath)
 	absTracker := trackerPath
@@ -102,41 +122,94 @@ func updateTrackerStatus(trackerPath, status string, dryRun bool) (string, error
 		absTracker = filepath.Join(baseDir, trackerPath)
 	}
 
-	contentBytes, err := os.ReadFile(absTracker)
+	doc, err := trackerpkg.Load(absTracker)
 	if err != nil {
-		return "", err
-	}
-	content := string(contentBytes)
-	if !strings.HasPrefix(content, "---") {
-		return "", fmt.Errorf("missing frontmatter")
-	}
-	parts := strings.SplitN(content, "---", 3)
-	if len(parts) < 3 {
-		return "", fmt.Errorf("invalid frontmatter")
-	}
-	raw := strings.TrimSuffix(parts[1], "\n")
-	lines := strings.Split(raw, "\n")
-	found := false
-	var updated []string
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-		if strings.HasPrefix(trimmed, "status:") {
-			updated = append(updated, "status: "+status)
-			found = true
-			continue
-		}
-		updated = append(updated, line)
+		return "", "", err
 	}
-	if !found {
-		updated = append(updated, "status: "+status)
+	previousStatus, err := doc.SetStatus(status)
+	if err != nil {
+		return "", "", err
 	}
-	newFrontmatter := strings.Join(updated, "\n")
-	newContent := strings.Join([]string{"---", newFrontmatter, "---"}, "\n") + parts[2]
+
 	if dryRun {
-		return absTracker, nil
+		diff, err := doc.DryRun()
+		return absTracker, diff, err
+	}
+
+	diff, err = doc.Save()
+	if err != nil {
+		return "", "", err
 	}
-	if err := os.WriteFile(absTracker, []byte(newContent), 0644); err != nil {
+	events.PublishTrackerStatusChanged(trackerSlugFromPath(absTracker), absTracker, previousStatus, status)
+	return absTracker, diff, nil
+}
+
+// runCareerTailor ensures a tracker's resume.tex exists (writing a fresh
+// shell from its frontmatter when missing, or when force is set) and
+// optionally compiles it to the resume_path PDF with pdflatex.
+func runCareerTailor(trackerPath string, compile, force bool, pdflatex string) (string, error) {
+	baseDir := resolveBaseDir()
+	trackerPath = expandHome(trackerPath)
+	absTracker := trackerPath
+	if !filepath.IsAbs(trackerPath) {
+		absTracker = filepath.Join(baseDir, trackerPath)
+	}
+
+	doc, err := trackerpkg.Load(absTracker)
+	if err != nil {
 		return "", err
 	}
-	return absTracker, nil
+
+	resumePDF := strings.Trim(doc.Frontmatter.ResumePath, "[]")
+	if resumePDF == "" {
+		return "", fmt.Errorf("tracker has no resume_path")
+	}
+	if !filepath.IsAbs(resumePDF) {
+		resumePDF = filepath.Join(baseDir, resumePDF)
+	}
+	resumeDir := filepath.Dir(resumePDF)
+	if err := os.MkdirAll(resumeDir, 0755); err != nil {
+		return "", err
+	}
+	texPath := strings.TrimSuffix(resumePDF, filepath.Ext(resumePDF)) + ".tex"
+
+	var result string
+	if _, err := os.Stat(texPath); force || os.IsNotExist(err) {
+		if err := writeResumeShell(texPath, doc.Frontmatter.Company, doc.Frontmatter.Position); err != nil {
+			return "", err
+		}
+		result = fmt.Sprintf("Wrote %s", texPath)
+	} else {
+		result = fmt.Sprintf("%s already exists (pass force to overwrite)", texPath)
+	}
+
+	if compile {
+		exe := pdflatex
+		if exe == "" {
+			exe = "pdflatex"
+		}
+		cmd := exec.Command(exe, "-interaction=nonstopmode", "-output-directory="+resumeDir, texPath)
+		var out bytes.Buffer
+		cmd.Stdout = &out
+		cmd.Stderr = &out
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("pdflatex failed: %w\n%s", err, out.String())
+		}
+		result += fmt.Sprintf("; compiled %s", resumePDF)
+	}
+
+	return result, nil
+}
+
+// writeResumeShell writes a minimal LaTeX document to texPath as a
+// starting point for tailoring, naming the company/position it was
+// generated for.
+func writeResumeShell(texPath, company, position string) error {
+	content := fmt.Sprintf(`%% Resume shell for %s - %s. Replace with the tailored resume body.
+\documentclass{article}
+\begin{document}
+Tailored resume for %s at %s.
+\end{document}
+`, company, position, position, company)
+	return os.WriteFile(texPath, []byte(content), 0644)
 }
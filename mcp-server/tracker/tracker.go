@@ -0,0 +1,225 @@
+// Package tracker loads and rewrites an Obsidian tracker markdown file's
+// YAML frontmatter via node-level round-tripping instead of line-prefix
+// string surgery, so comments, key order, and nested values survive edits
+// that only ever touched a handful of top-level fields before.
+package tracker
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Frontmatter is the typed view over a tracker's known YAML keys. Any key
+// this package does not model (custom fields a user adds by hand) round-
+// trips through Extra instead of being dropped.
+type Frontmatter struct {
+	Company         string                 `yaml:"company"`
+	Position        string                 `yaml:"position"`
+	Status          string                 `yaml:"status"`
+	NextAction      []string               `yaml:"next_action"`
+	Salary          float64                `yaml:"salary"`
+	ApplicationDate string                 `yaml:"application_date"`
+	Website         string                 `yaml:"website"`
+	ReferenceLink   string                 `yaml:"reference_link"`
+	ResumePath      string                 `yaml:"resume_path"`
+	CoverLetterPath string                 `yaml:"cover_letter_path"`
+	Extra           map[string]interface{} `yaml:",inline"`
+}
+
+// Doc is a loaded tracker file: a YAML node tree for the frontmatter
+// (preserving comments and key order on re-encode), a typed Frontmatter
+// view kept in sync with it, and the markdown body that follows.
+type Doc struct {
+	Path        string
+	Frontmatter Frontmatter
+	Body        string
+
+	node yaml.Node
+	raw  string
+}
+
+// Load reads and parses a tracker file's frontmatter and body.
+func Load(path string) (*Doc, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	content := string(raw)
+	if !strings.HasPrefix(content, "---") {
+		return nil, fmt.Errorf("missing frontmatter")
+	}
+	parts := strings.SplitN(content, "---", 3)
+	if len(parts) < 3 {
+		return nil, fmt.Errorf("invalid frontmatter")
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(parts[1]), &node); err != nil {
+		return nil, fmt.Errorf("parse frontmatter: %w", err)
+	}
+	var fm Frontmatter
+	if err := node.Decode(&fm); err != nil {
+		return nil, fmt.Errorf("decode frontmatter: %w", err)
+	}
+
+	return &Doc{
+		Path:        path,
+		Frontmatter: fm,
+		node:        node,
+		Body:        parts[2],
+		raw:         content,
+	}, nil
+}
+
+// New builds a Doc for a tracker that does not exist on disk yet, from a
+// typed Frontmatter and the markdown body to follow it, so freshly
+// created trackers serialize through the same node tree as loaded ones.
+func New(path string, fm Frontmatter, body string) (*Doc, error) {
+	data, err := yaml.Marshal(fm)
+	if err != nil {
+		return nil, err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	return &Doc{Path: path, Frontmatter: fm, node: node, Body: body}, nil
+}
+
+// SetStatus sets the status field and returns its previous value.
+func (d *Doc) SetStatus(status string) (previous string, err error) {
+	previous = d.Frontmatter.Status
+	return previous, d.PatchField("status", status)
+}
+
+// PatchField sets an arbitrary frontmatter key to value, preserving
+// comments and key order for every other key. A nil value removes the
+// key. Known keys (see Frontmatter) also update the typed view; unknown
+// keys round-trip through Frontmatter.Extra.
+func (d *Doc) PatchField(key string, value interface{}) error {
+	mapping := d.mappingNode()
+	if value == nil {
+		return d.deleteField(key)
+	}
+
+	var valueNode yaml.Node
+	if err := valueNode.Encode(value); err != nil {
+		return err
+	}
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = &valueNode
+			return d.refreshTyped()
+		}
+	}
+	mapping.Content = append(mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Value: key},
+		&valueNode,
+	)
+	return d.refreshTyped()
+}
+
+func (d *Doc) deleteField(key string) error {
+	mapping := d.mappingNode()
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			break
+		}
+	}
+	return d.refreshTyped()
+}
+
+func (d *Doc) refreshTyped() error {
+	// Decode into a fresh value: decoding into d.Frontmatter in place would
+	// keep the old value of any key that was just removed.
+	var fm Frontmatter
+	if err := d.mappingNode().Decode(&fm); err != nil {
+		return err
+	}
+	d.Frontmatter = fm
+	return nil
+}
+
+func (d *Doc) mappingNode() *yaml.Node {
+	if d.node.Kind == yaml.DocumentNode && len(d.node.Content) > 0 {
+		return d.node.Content[0]
+	}
+	return &d.node
+}
+
+// Render serializes the current frontmatter node tree and body back into
+// full tracker file content, without writing anything to disk.
+func (d *Doc) Render() (string, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&d.node); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	frontmatter := strings.TrimRight(buf.String(), "\n")
+	return "---\n" + frontmatter + "\n---" + d.Body, nil
+}
+
+// DryRun renders the document without writing it, returning a diff
+// against the content as last loaded (or empty, for a new Doc).
+func (d *Doc) DryRun() (string, error) {
+	content, err := d.Render()
+	if err != nil {
+		return "", err
+	}
+	return Diff(d.raw, content), nil
+}
+
+// Save renders and writes the document to Path, returning a diff against
+// the content as last loaded (or saved).
+func (d *Doc) Save() (diff string, err error) {
+	content, err := d.Render()
+	if err != nil {
+		return "", err
+	}
+	diff = Diff(d.raw, content)
+	if err := os.WriteFile(d.Path, []byte(content), 0644); err != nil {
+		return "", err
+	}
+	d.raw = content
+	return diff, nil
+}
+
+// Diff returns a minimal diff between two versions of a tracker file's
+// content: unchanged leading and trailing lines are elided, and the
+// lines that actually differ are shown with "-"/"+" prefixes.
+func Diff(before, after string) string {
+	if before == after {
+		return ""
+	}
+	beforeLines := strings.Split(before, "\n")
+	afterLines := strings.Split(after, "\n")
+
+	start := 0
+	for start < len(beforeLines) && start < len(afterLines) && beforeLines[start] == afterLines[start] {
+		start++
+	}
+	endB, endA := len(beforeLines), len(afterLines)
+	for endB > start && endA > start && beforeLines[endB-1] == afterLines[endA-1] {
+		endB--
+		endA--
+	}
+
+	var b strings.Builder
+	for _, line := range beforeLines[start:endB] {
+		b.WriteString("-" + line + "\n")
+	}
+	for _, line := range afterLines[start:endA] {
+		b.WriteString("+" + line + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
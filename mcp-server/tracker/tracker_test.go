@@ -0,0 +1,140 @@
+package tracker
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleTracker = `---
+company: Acme Corp
+position: Staff Engineer
+status: Applied
+next_action:
+  - Wait for feedback
+salary: 0
+application_date: 2026-01-01
+website: https://acme.example
+reference_link: https://acme.example/jobs/1
+resume_path: data/applications/acme/resume/resume.pdf
+cover_letter_path: data/applications/acme/cover/cover-letter.pdf
+actions:
+  archive: mv {{.ResumePath}} archive/
+---
+
+## Job Description
+Build things.
+
+## Notes
+- Created via Kaka Go MCP Tool
+`
+
+func writeSample(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "tracker.md")
+	if err := os.WriteFile(path, []byte(sampleTracker), 0644); err != nil {
+		t.Fatalf("write sample tracker: %v", err)
+	}
+	return path
+}
+
+func TestLoadParsesFrontmatterAndBody(t *testing.T) {
+	doc, err := Load(writeSample(t))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if doc.Frontmatter.Company != "Acme Corp" {
+		t.Errorf("Company = %q, want %q", doc.Frontmatter.Company, "Acme Corp")
+	}
+	if doc.Frontmatter.Status != "Applied" {
+		t.Errorf("Status = %q, want %q", doc.Frontmatter.Status, "Applied")
+	}
+	if !strings.Contains(doc.Body, "## Job Description") {
+		t.Errorf("Body missing expected section, got %q", doc.Body)
+	}
+	if _, ok := doc.Frontmatter.Extra["actions"]; !ok {
+		t.Errorf("Extra missing unmodeled \"actions\" key: %v", doc.Frontmatter.Extra)
+	}
+}
+
+func TestPatchFieldUpdatesKnownFieldAndRoundTrips(t *testing.T) {
+	path := writeSample(t)
+	doc, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	previous, err := doc.SetStatus("Interviewing")
+	if err != nil {
+		t.Fatalf("SetStatus: %v", err)
+	}
+	if previous != "Applied" {
+		t.Errorf("previous status = %q, want %q", previous, "Applied")
+	}
+	if doc.Frontmatter.Status != "Interviewing" {
+		t.Errorf("Frontmatter.Status = %q, want %q", doc.Frontmatter.Status, "Interviewing")
+	}
+
+	rendered, err := doc.Render()
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	reloaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	// Nothing has been saved yet, so Render's output must carry the
+	// patched status while the file on disk still has the old one.
+	if !strings.Contains(rendered, "status: Interviewing") {
+		t.Errorf("rendered content missing patched status:\n%s", rendered)
+	}
+	if reloaded.Frontmatter.Status != "Applied" {
+		t.Errorf("reloaded (unsaved) file status = %q, want unchanged %q", reloaded.Frontmatter.Status, "Applied")
+	}
+
+	// The unmodeled actions block and the body should survive the patch.
+	if !strings.Contains(rendered, "archive: mv {{.ResumePath}} archive/") {
+		t.Errorf("rendered content dropped unmodeled actions block:\n%s", rendered)
+	}
+	if !strings.Contains(rendered, "## Job Description") {
+		t.Errorf("rendered content dropped body:\n%s", rendered)
+	}
+}
+
+func TestPatchFieldDeleteRemovesKey(t *testing.T) {
+	doc, err := Load(writeSample(t))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if err := doc.PatchField("website", nil); err != nil {
+		t.Fatalf("PatchField delete: %v", err)
+	}
+	rendered, err := doc.Render()
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if strings.Contains(rendered, "website:") {
+		t.Errorf("rendered content still has deleted key:\n%s", rendered)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	cases := []struct {
+		name          string
+		before, after string
+		want          string
+	}{
+		{"identical", "a\nb\nc", "a\nb\nc", ""},
+		{"middle line changed", "a\nb\nc", "a\nx\nc", "-b\n+x"},
+		{"appended line", "a\nb", "a\nb\nc", "+c"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Diff(c.before, c.after); got != c.want {
+				t.Errorf("Diff(%q, %q) = %q, want %q", c.before, c.after, got, c.want)
+			}
+		})
+	}
+}
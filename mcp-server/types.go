@@ -16,3 +16,13 @@ type TrackerData struct {
 	ReferenceLink string
 	JobDesc       string
 }
+
+// JobSearchResult is one search_jobs hit: a captured job plus its status
+// and the snippet/rank produced by the search backend (FTS5 or LIKE).
+type JobSearchResult struct {
+	JobRecord
+	Status             string
+	TitleSnippet       string
+	DescriptionSnippet string
+	Rank               float64
+}
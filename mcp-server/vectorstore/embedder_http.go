@@ -0,0 +1,81 @@
+package vectorstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HTTPEmbedder calls an OpenAI- or Ollama-compatible /embeddings endpoint.
+// Both APIs accept {"model": ..., "input"/"prompt": ...} and return a
+// "data[0].embedding" or "embedding" field, so one struct covers both by
+// trying the OpenAI shape first and falling back to the Ollama shape.
+type HTTPEmbedder struct {
+	Endpoint string
+	ModelID  string
+	APIKey   string
+	Client   *http.Client
+}
+
+func NewHTTPEmbedder(endpoint, model, apiKey string) *HTTPEmbedder {
+	return &HTTPEmbedder{
+		Endpoint: endpoint,
+		ModelID:  model,
+		APIKey:   apiKey,
+		Client:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (e *HTTPEmbedder) Model() string {
+	return e.ModelID
+}
+
+func (e *HTTPEmbedder) Embed(ctx context.Context, text string) ([]float32, error) {
+	body, err := json.Marshal(map[string]string{
+		"model":  e.ModelID,
+		"input":  text,
+		"prompt": text,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+e.APIKey)
+	}
+
+	resp, err := e.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("embed request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embed request returned status %d", resp.StatusCode)
+	}
+
+	var parsed struct {
+		Data []struct {
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+		Embedding []float32 `json:"embedding"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("decode embed response: %w", err)
+	}
+	if len(parsed.Data) > 0 && len(parsed.Data[0].Embedding) > 0 {
+		return parsed.Data[0].Embedding, nil
+	}
+	if len(parsed.Embedding) > 0 {
+		return parsed.Embedding, nil
+	}
+	return nil, fmt.Errorf("embed response had no embedding")
+}
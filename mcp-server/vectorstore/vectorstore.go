@@ -0,0 +1,165 @@
+// Package vectorstore persists per-job embedding vectors in the existing
+// SQLite capture DB and computes cosine similarity in Go, so the embedding
+// classifier needs no vector database beyond the database the rest of the
+// module already uses.
+package vectorstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Embedder turns text into a fixed-dimension embedding vector.
+type Embedder interface {
+	Embed(ctx context.Context, text string) ([]float32, error)
+	Model() string
+}
+
+// Store wraps the capture DB's job_embeddings table.
+type Store struct {
+	db *sql.DB
+}
+
+func NewStore(db *sql.DB) *Store {
+	return &Store{db: db}
+}
+
+// EnsureSchema creates the job_embeddings table if it does not already
+// exist. It is safe to call on every startup.
+func (s *Store) EnsureSchema() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS job_embeddings (
+			url        TEXT PRIMARY KEY,
+			label      TEXT NOT NULL DEFAULT '',
+			model      TEXT NOT NULL,
+			dim        INTEGER NOT NULL,
+			vector     BLOB NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		)
+	`)
+	return err
+}
+
+// Put stores (or replaces) the embedding for a job URL. label is empty for
+// ordinary captured jobs and set to the anchor class ("shortlist",
+// "reviewed" or "reject") for anchor examples indexed via train_anchors.
+func (s *Store) Put(url, label, model string, vector []float32) error {
+	_, err := s.db.Exec(`
+		INSERT INTO job_embeddings (url, label, model, dim, vector, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(url) DO UPDATE SET
+			label = excluded.label,
+			model = excluded.model,
+			dim = excluded.dim,
+			vector = excluded.vector,
+			created_at = excluded.created_at
+	`, url, label, model, len(vector), encodeVector(vector), time.Now().UTC())
+	return err
+}
+
+// AnchorsByLabel returns every stored anchor vector grouped by label
+// ("shortlist", "reviewed", "reject").
+func (s *Store) AnchorsByLabel(model string) (map[string][][]float32, error) {
+	rows, err := s.db.Query(`
+		SELECT label, vector FROM job_embeddings
+		WHERE label != '' AND model = ?
+	`, model)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[string][][]float32)
+	for rows.Next() {
+		var label string
+		var raw []byte
+		if err := rows.Scan(&label, &raw); err != nil {
+			continue
+		}
+		out[label] = append(out[label], decodeVector(raw))
+	}
+	return out, rows.Err()
+}
+
+// encodeVector/decodeVector store a []float32 as a little-endian BLOB so
+// similarity search never has to leave Go or load an external vector DB.
+func encodeVector(vector []float32) []byte {
+	buf := make([]byte, 4*len(vector))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(v))
+	}
+	return buf
+}
+
+func decodeVector(raw []byte) []float32 {
+	out := make([]float32, len(raw)/4)
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(raw[i*4:]))
+	}
+	return out
+}
+
+// CosineSimilarity returns the cosine similarity of a and b in [-1, 1], or
+// 0 if either vector has zero magnitude.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, magA, magB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		magA += float64(a[i]) * float64(a[i])
+		magB += float64(b[i]) * float64(b[i])
+	}
+	if magA == 0 || magB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(magA) * math.Sqrt(magB))
+}
+
+// MeanTopK averages the k highest similarity scores between vec and each
+// of the candidate vectors. It is used to compare a job embedding against
+// an anchor class's examples without collapsing them into one centroid.
+func MeanTopK(vec []float32, candidates [][]float32, k int) float64 {
+	if len(candidates) == 0 {
+		return 0
+	}
+	scores := make([]float64, len(candidates))
+	for i, c := range candidates {
+		scores[i] = CosineSimilarity(vec, c)
+	}
+	for i := 0; i < len(scores); i++ {
+		for j := i + 1; j < len(scores); j++ {
+			if scores[j] > scores[i] {
+				scores[i], scores[j] = scores[j], scores[i]
+			}
+		}
+	}
+	if k > len(scores) {
+		k = len(scores)
+	}
+	if k <= 0 {
+		return 0
+	}
+	var sum float64
+	for _, sc := range scores[:k] {
+		sum += sc
+	}
+	return sum / float64(k)
+}
+
+var errDimMismatch = fmt.Errorf("vectorstore: embedding dimension mismatch")
+
+// ValidateDim reports an error when vector does not have the expected
+// dimension, so a model swap that changes dim is caught before bad
+// comparisons are made instead of silently returning 0 similarity.
+func ValidateDim(vector []float32, dim int) error {
+	if dim > 0 && len(vector) != dim {
+		return fmt.Errorf("%w: got %d, want %d", errDimMismatch, len(vector), dim)
+	}
+	return nil
+}
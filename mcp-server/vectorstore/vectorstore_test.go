@@ -0,0 +1,63 @@
+package vectorstore
+
+import "testing"
+
+func TestCosineSimilarity(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b []float32
+		want float64
+	}{
+		{"identical", []float32{1, 0, 0}, []float32{1, 0, 0}, 1},
+		{"orthogonal", []float32{1, 0}, []float32{0, 1}, 0},
+		{"opposite", []float32{1, 0}, []float32{-1, 0}, -1},
+		{"length mismatch", []float32{1, 0}, []float32{1, 0, 0}, 0},
+		{"empty", nil, nil, 0},
+		{"zero magnitude", []float32{0, 0}, []float32{1, 1}, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := CosineSimilarity(c.a, c.b); got != c.want {
+				t.Errorf("CosineSimilarity(%v, %v) = %v, want %v", c.a, c.b, got, c.want)
+			}
+		})
+	}
+}
+
+func TestMeanTopK(t *testing.T) {
+	vec := []float32{1, 0}
+	candidates := [][]float32{
+		{1, 0},  // similarity 1
+		{0, 1},  // similarity 0
+		{-1, 0}, // similarity -1
+	}
+
+	if got := MeanTopK(vec, candidates, 1); got != 1 {
+		t.Errorf("top-1 mean = %v, want 1", got)
+	}
+	if got, want := MeanTopK(vec, candidates, 2), 0.5; got != want {
+		t.Errorf("top-2 mean = %v, want %v", got, want)
+	}
+	if got := MeanTopK(vec, candidates, 10); got != 0 {
+		// k clamps to len(candidates): average of all three scores (1, 0, -1) = 0.
+		t.Errorf("k beyond len(candidates) = %v, want 0", got)
+	}
+	if got := MeanTopK(vec, nil, 1); got != 0 {
+		t.Errorf("no candidates = %v, want 0", got)
+	}
+	if got := MeanTopK(vec, candidates, 0); got != 0 {
+		t.Errorf("k=0 = %v, want 0", got)
+	}
+}
+
+func TestValidateDim(t *testing.T) {
+	if err := ValidateDim([]float32{1, 2, 3}, 3); err != nil {
+		t.Errorf("expected no error for matching dim, got %v", err)
+	}
+	if err := ValidateDim([]float32{1, 2}, 3); err == nil {
+		t.Error("expected error for mismatched dim, got nil")
+	}
+	if err := ValidateDim([]float32{1, 2}, 0); err != nil {
+		t.Errorf("expected no error when dim is unset (0), got %v", err)
+	}
+}